@@ -0,0 +1,38 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// genreStats is a row of the aggregation of books per genre. The sql tags name its columns for
+// Ent, while GORM and Bun derive the same names from the fields.
+type genreStats struct {
+	Genre           string
+	Books           int64
+	TotalQuantity   int64   `sql:"total_quantity"`
+	AverageQuantity float64 `sql:"average_quantity"`
+}
+
+// aggregateBenchmark seeds utils.BulkInsertNumber books spread across model.Genres, then
+// measures aggregate counting the books and summing and averaging their quantity per genre.
+func aggregateBenchmark(b *testing.B, aggregate func() error) {
+	if err := truncateBooks(); err != nil {
+		b.Error(err)
+		return
+	}
+	if err := seedBooks(model.NewBooksAcrossGenres[int64](utils.BulkInsertNumber)); err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				return aggregate()
+			},
+		}
+	})
+}
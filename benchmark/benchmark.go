@@ -1,8 +1,12 @@
 package benchmark
 
 import (
+	"cmp"
+	"slices"
 	"testing"
 
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/histogram"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/stats"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
 )
 
@@ -16,6 +20,41 @@ type Benchmark interface {
 	Delete(b *testing.B)
 	FindByID(b *testing.B)
 	FindPage(b *testing.B)
+	FindWithActivePolicy(b *testing.B)
+	// FindPageWithPolicies returns the benchmark of the strategy, or nil when the library lacks it.
+	FindPageWithPolicies(strategy LoadStrategy) func(b *testing.B)
+	// Transaction inserts a book along with its price policies in a transaction and commits it,
+	// while TransactionRollback rolls it back.
+	Transaction(b *testing.B)
+	TransactionRollback(b *testing.B)
+	Upsert(b *testing.B)
+	// UpdateBulk and DeleteBulk return the benchmarks of statements changing the given number of books.
+	UpdateBulk(affected int) func(b *testing.B)
+	DeleteBulk(affected int) func(b *testing.B)
+	// FindByIDs returns the benchmark of the way of passing IDs, or nil when the library lacks it.
+	FindByIDs(list IDList) func(b *testing.B)
+	Aggregate(b *testing.B)
+	Search(b *testing.B)
+	// FindPageAt returns the benchmark of fetching a page after depth books with the given pagination.
+	FindPageAt(pagination Pagination, depth int) func(b *testing.B)
+	// FindTitles fetches pages of the id and title of books, without the other columns.
+	FindTitles(b *testing.B)
+	// ScanLarge returns the benchmark of the way of reading every book, or nil when the library lacks it.
+	ScanLarge(mode ScanMode) func(b *testing.B)
+	// InsertNullable, FindNullable and UpdateNullable work on the schema variant with nullable columns,
+	// half of whose values are NULL.
+	InsertNullable(b *testing.B)
+	FindNullable(b *testing.B)
+	UpdateNullable(b *testing.B)
+	// InsertJSON and FindJSON return the benchmarks of writing and reading books of the schema variant
+	// with a JSONB metadata column, holding documents of the given size.
+	InsertJSON(size DocumentSize) func(b *testing.B)
+	FindJSON(size DocumentSize) func(b *testing.B)
+	// InsertTags, FindTags and FilterTags work on the schema variant with a TEXT[] tags column, FilterTags
+	// fetching the books having every given tag with @>.
+	InsertTags(b *testing.B)
+	FindTags(b *testing.B)
+	FilterTags(b *testing.B)
 }
 
 func BeforeBenchmark() {
@@ -23,7 +62,39 @@ func BeforeBenchmark() {
 }
 
 type ResultWrapper struct {
-	Orm        string
+	Orm string
+	// Benchmarks holds the run with the median ns/op of each operation, keyed by operation.
 	Benchmarks map[string]testing.BenchmarkResult
-	Err        error
+	// Samples holds every run of each operation, in execution order, keyed by operation.
+	Samples map[string][]testing.BenchmarkResult
+	// Latencies holds the per-operation latency histogram of each benchmark, keyed by operation.
+	Latencies map[string]*histogram.Histogram
+	Err       error
+}
+
+// Summary returns the statistical summary of the ns/op of every run of the operation.
+func (r ResultWrapper) Summary(operation string) stats.Summary {
+	samples, ok := r.Samples[operation]
+	if !ok {
+		if result, found := r.Benchmarks[operation]; found {
+			samples = []testing.BenchmarkResult{result}
+		}
+	}
+	values := make([]float64, len(samples))
+	for i, sample := range samples {
+		values[i] = float64(sample.T.Nanoseconds()) / float64(max(sample.N, 1))
+	}
+	return stats.Summarize(values)
+}
+
+// MedianRun returns the run whose ns/op is the median of the given ones.
+func MedianRun(samples []testing.BenchmarkResult) testing.BenchmarkResult {
+	if len(samples) == 0 {
+		return testing.BenchmarkResult{}
+	}
+	sorted := slices.Clone(samples)
+	slices.SortFunc(sorted, func(a, b testing.BenchmarkResult) int {
+		return cmp.Compare(a.NsPerOp(), b.NsPerOp())
+	})
+	return sorted[(len(sorted)-1)/2]
 }
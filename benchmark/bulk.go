@@ -0,0 +1,74 @@
+package benchmark
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+)
+
+// AffectedSizes lists the number of books UpdateBulk and DeleteBulk change per statement.
+var AffectedSizes = []int{10, 100, 1000}
+
+var genreSequence atomic.Int64
+
+// newGenre returns a genre that no seeded book has yet, so it matches only the books seeded with it.
+func newGenre() string {
+	return fmt.Sprintf("Genre %d", genreSequence.Add(1))
+}
+
+// inStatements calls insert with consecutive chunks of at most utils.MaxBooksPerStatement rows,
+// splitting the rows of a multi-row INSERT that would exceed the bind parameters of a statement.
+func inStatements[T any](rows []T, insert func(chunk []T) error) error {
+	for len(rows) > 0 {
+		n := min(len(rows), utils.MaxBooksPerStatement)
+		if err := insert(rows[:n]); err != nil {
+			return err
+		}
+		rows = rows[n:]
+	}
+	return nil
+}
+
+// updateBulkBenchmark seeds affected books of a genre per goroutine, along with as many books of
+// another genre, then measures update setting the quantity of every book of the genre.
+func updateBulkBenchmark(affected int, update func(genre string, quantity int) error) func(b *testing.B) {
+	return func(b *testing.B) {
+		run(b, func() step {
+			genre := newGenre()
+			if err := seedGenres([]string{genre, newGenre()}, affected); err != nil {
+				b.Error(err)
+				return step{exec: func(int) error { return err }}
+			}
+			return step{
+				exec: func(i int) error {
+					return update(genre, i)
+				},
+			}
+		})
+	}
+}
+
+// deleteBulkBenchmark seeds affected books of a distinct genre per iteration, then measures
+// remove deleting every book of the genre of the iteration.
+func deleteBulkBenchmark(affected int, remove func(genre string) error) func(b *testing.B) {
+	return func(b *testing.B) {
+		genres := make([]string, b.N)
+		for i := range genres {
+			genres[i] = newGenre()
+		}
+		if err := seedGenres(genres, affected); err != nil {
+			b.Error(err)
+			return
+		}
+
+		run(b, func() step {
+			return step{
+				exec: func(i int) error {
+					return remove(genres[i])
+				},
+			}
+		})
+	}
+}
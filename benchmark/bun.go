@@ -3,182 +3,478 @@ package benchmark
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
 	"github.com/andreiac-silva/golang-orm-benchmarks/model"
 
+	"github.com/google/uuid"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/driver/pgdriver"
 )
 
-type BunBenchmark struct {
+type BunBenchmark[K model.Key] struct {
 	db  *bun.DB
 	ctx context.Context
 }
 
 func NewBunBenchmark() Benchmark {
-	return &BunBenchmark{ctx: context.Background()}
+	if utils.PrimaryKey == utils.UUIDKey {
+		return &BunBenchmark[uuid.UUID]{ctx: context.Background()}
+	}
+	return &BunBenchmark[int64]{ctx: context.Background()}
 }
 
-func (o *BunBenchmark) Init() error {
+func (o *BunBenchmark[K]) Init() error {
 	sqldb := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(utils.PostgresDSN)))
+	utils.ConfigurePool(sqldb)
 	o.db = bun.NewDB(sqldb, pgdialect.New())
 	return nil
 }
 
-func (o *BunBenchmark) Close() error {
+func (o *BunBenchmark[K]) Close() error {
 	return o.db.Close()
 }
 
-func (o *BunBenchmark) Insert(b *testing.B) {
-	book := model.NewBook()
-
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		book.ID = 0
-		b.StartTimer()
-
-		_, err := o.db.NewInsert().Model(book).Exec(o.ctx)
-
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+func (o *BunBenchmark[K]) Insert(b *testing.B) {
+	run(b, func() step {
+		book := model.NewBook[K]()
+		var zero K
+		return step{
+			prepare: func(int) {
+				book.ID = zero
+			},
+			exec: func(int) error {
+				_, err := o.db.NewInsert().Model(book).Exec(o.ctx)
+				return err
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
-func (o *BunBenchmark) InsertBulk(b *testing.B) {
-	books := model.NewBooks(utils.BulkInsertNumber)
-
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		for _, book := range books {
-			book.ID = 0
+func (o *BunBenchmark[K]) InsertBulk(b *testing.B) {
+	run(b, func() step {
+		books := model.NewBooks[K](utils.BulkInsertNumber)
+		var zero K
+		return step{
+			prepare: func(int) {
+				for _, book := range books {
+					book.ID = zero
+				}
+			},
+			exec: func(int) error {
+				return inStatements(books, func(chunk []*model.Book[K]) error {
+					_, err := o.db.NewInsert().Model(&chunk).Exec(o.ctx)
+					return err
+				})
+			},
 		}
-		b.StartTimer()
-
-		_, err := o.db.NewInsert().Model(&books).Exec(o.ctx)
+	})
+}
 
-		b.StopTimer()
-		if err != nil {
+func (o *BunBenchmark[K]) Update(b *testing.B) {
+	run(b, func() step {
+		book := model.NewBook[K]()
+		if _, err := o.db.NewInsert().Model(book).Exec(o.ctx); err != nil {
 			b.Error(err)
+			return step{exec: func(int) error { return err }}
 		}
-		b.StartTimer()
-	}
+		return step{
+			exec: func(int) error {
+				_, err := o.db.NewUpdate().Model(book).WherePK().Exec(o.ctx)
+				return err
+			},
+		}
+	})
 }
 
-func (o *BunBenchmark) Update(b *testing.B) {
-	book := model.NewBook()
+func (o *BunBenchmark[K]) Delete(b *testing.B) {
+	n := b.N
+	books := model.NewBooks[K](n)
 
-	_, err := o.db.NewInsert().Model(book).Exec(o.ctx)
+	_, err := o.db.NewInsert().Model(&books).Exec(o.ctx)
 	if err != nil {
 		b.Error(err)
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		_, err = o.db.NewUpdate().Model(book).WherePK().Exec(o.ctx)
-
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		var book *model.Book[K]
+		return step{
+			prepare: func(i int) {
+				book = new(model.Book[K])
+				book.ID = books[i].ID
+			},
+			exec: func(int) error {
+				_, err := o.db.NewDelete().Model(book).WherePK().Exec(o.ctx)
+				return err
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
-func (o *BunBenchmark) Delete(b *testing.B) {
+func (o *BunBenchmark[K]) FindByID(b *testing.B) {
 	n := b.N
-	books := model.NewBooks(n)
-
+	books := model.NewBooks[K](n)
 	_, err := o.db.NewInsert().Model(&books).Exec(o.ctx)
 	if err != nil {
 		b.Error(err)
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	var book *model.Book
-	for i := 0; i < n; i++ {
-		b.StopTimer()
-		book = new(model.Book)
-		book.ID = books[i].ID
-		b.StartTimer()
-
-		_, err = o.db.NewDelete().Model(book).WherePK().Exec(o.ctx)
-
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		var book *model.Book[K]
+		return step{
+			prepare: func(int) {
+				book = new(model.Book[K])
+			},
+			exec: func(i int) error {
+				return o.db.NewSelect().Model(book).Where("id = ?", books[i].ID).Scan(o.ctx)
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
-func (o *BunBenchmark) FindByID(b *testing.B) {
+func (o *BunBenchmark[K]) FindPage(b *testing.B) {
 	n := b.N
-	books := model.NewBooks(n)
+	books := model.NewBooks[K](n)
 	_, err := o.db.NewInsert().Model(&books).Exec(o.ctx)
 	if err != nil {
 		b.Error(err)
 	}
+	keys := bookKeys(books)
+
+	run(b, func() step {
+		var booksPage []model.Book[K]
+		return step{
+			prepare: func(int) {
+				booksPage = make([]model.Book[K], utils.PageSize)
+			},
+			exec: func(i int) error {
+				return o.db.NewSelect().Model(&booksPage).Where("id > ?", cursorAt(keys, i)).Order("id").Limit(utils.PageSize).Scan(o.ctx)
+			},
+		}
+	})
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	var book *model.Book
-	var bookID int64
-	for i := 0; i < n; i++ {
-		b.StopTimer()
-		book = new(model.Book)
-		bookID = books[i].ID
-		b.StartTimer()
+func (o *BunBenchmark[K]) FindWithActivePolicy(b *testing.B) {
+	now := time.Now().UTC()
+	keys, err := seedPricedBooks[K](b.N, now)
+	if err != nil {
+		b.Error(err)
+		return
+	}
 
-		err = o.db.NewSelect().Model(book).Where("id = ?", bookID).Scan(o.ctx)
+	// Has-one relations are joined, so their conditions filter the books too.
+	active := func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("active_policy.start_date <= ?", now).Where("active_policy.end_date > ?", now)
+	}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		var booksPage []model.Book[K]
+		return step{
+			prepare: func(int) {
+				booksPage = make([]model.Book[K], utils.PageSize)
+			},
+			exec: func(i int) error {
+				return o.db.NewSelect().
+					Model(&booksPage).
+					Relation("ActivePolicy", active).
+					Where("book.id > ?", cursorAt(keys, i)).
+					OrderExpr("book.id").
+					Limit(utils.PageSize).
+					Scan(o.ctx)
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
-func (o *BunBenchmark) FindPage(b *testing.B) {
-	n := b.N
-	books := model.NewBooks(n)
-	_, err := o.db.NewInsert().Model(&books).Exec(o.ctx)
-	if err != nil {
-		b.Error(err)
+func (o *BunBenchmark[K]) FindPageWithPolicies(strategy LoadStrategy) func(b *testing.B) {
+	switch strategy {
+	case NPlusOne:
+		return eagerBenchmark(func(cursor K) error {
+			var books []model.Book[K]
+			if err := o.db.NewSelect().Model(&books).Where("id > ?", cursor).Order("id").Limit(utils.PageSize).Scan(o.ctx); err != nil {
+				return err
+			}
+			for j := range books {
+				err := o.db.NewSelect().Model(&books[j].Policies).Where("book_id = ?", books[j].ID).OrderExpr("id").Scan(o.ctx)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	case Preload:
+		// Has-many relations are loaded with a second query.
+		return eagerBenchmark(func(cursor K) error {
+			var books []model.Book[K]
+			return o.db.NewSelect().Model(&books).Relation("Policies").Where("book.id > ?", cursor).OrderExpr("book.id").Limit(utils.PageSize).Scan(o.ctx)
+		})
+	case Join:
+		return eagerBenchmark(func(cursor K) error {
+			var rows []bookPolicyRow[K]
+			page := o.db.NewSelect().Model((*model.Book[K])(nil)).Column("id").Where("id > ?", cursor).Order("id").Limit(utils.PageSize)
+			err := o.db.NewSelect().
+				Model((*model.Book[K])(nil)).
+				ColumnExpr("book.*").
+				ColumnExpr("pp.id AS policy_id, pp.price, pp.start_date, pp.end_date").
+				Join("JOIN price_policies AS pp ON pp.book_id = book.id").
+				Where("book.id IN (?)", page).
+				OrderExpr("book.id, pp.id").
+				Scan(o.ctx, &rows)
+			if err != nil {
+				return err
+			}
+			_ = groupPolicies(rows)
+			return nil
+		})
 	}
+	return nil
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+func (o *BunBenchmark[K]) Transaction(b *testing.B) {
+	o.transaction(b, false)
+}
 
-	booksPage := make([]model.Book, utils.PageSize)
-	for i := 0; i < n; i++ {
-		b.StopTimer()
-		booksPage = make([]model.Book, utils.PageSize)
-		b.StartTimer()
+func (o *BunBenchmark[K]) TransactionRollback(b *testing.B) {
+	o.transaction(b, true)
+}
 
-		err = o.db.NewSelect().Model(&booksPage).Where("id > ?", i).Limit(utils.PageSize).Scan(o.ctx)
+func (o *BunBenchmark[K]) transaction(b *testing.B, rollback bool) {
+	now := time.Now().UTC()
+
+	run(b, func() step {
+		book := model.NewBook[K]()
+		var zero K
+		return step{
+			prepare: func(int) {
+				book.ID = zero
+			},
+			exec: func(int) error {
+				err := o.db.RunInTx(o.ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+					if _, err := tx.NewInsert().Model(book).Exec(ctx); err != nil {
+						return err
+					}
+					policies := model.NewPricePolicies(book.ID, now)
+					if _, err := tx.NewInsert().Model(&policies).Exec(ctx); err != nil {
+						return err
+					}
+					if rollback {
+						return errRollback
+					}
+					return nil
+				})
+				if errors.Is(err, errRollback) {
+					return nil
+				}
+				return err
+			},
+		}
+	})
+}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+func (o *BunBenchmark[K]) Upsert(b *testing.B) {
+	upsertBenchmark(b, func(books []*model.Book[K]) error {
+		return inStatements(books, func(chunk []*model.Book[K]) error {
+			_, err := o.db.NewInsert().
+				Model(&chunk).
+				On("CONFLICT (isbn) DO UPDATE").
+				Set("quantity = EXCLUDED.quantity").
+				Exec(o.ctx)
+			return err
+		})
+	})
+}
+
+func (o *BunBenchmark[K]) UpdateBulk(affected int) func(b *testing.B) {
+	return updateBulkBenchmark(affected, func(genre string, quantity int) error {
+		_, err := o.db.NewUpdate().
+			Model((*model.Book[K])(nil)).
+			Set("quantity = ?", quantity).
+			Where("genre = ?", genre).
+			Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark[K]) DeleteBulk(affected int) func(b *testing.B) {
+	return deleteBulkBenchmark(affected, func(genre string) error {
+		_, err := o.db.NewDelete().
+			Model((*model.Book[K])(nil)).
+			Where("genre = ?", genre).
+			Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark[K]) FindByIDs(list IDList) func(b *testing.B) {
+	switch list {
+	case InList:
+		return manyIDsBenchmark(func(ids []K) error {
+			var books []model.Book[K]
+			return o.db.NewSelect().Model(&books).Where("id IN (?)", bun.In(ids)).Scan(o.ctx)
+		})
+	case AnyArray:
+		return manyIDsBenchmark(func(ids []K) error {
+			var books []model.Book[K]
+			return o.db.NewSelect().Model(&books).Where("id = ANY(?)", pgdialect.Array(ids)).Scan(o.ctx)
+		})
+	}
+	return nil
+}
+
+func (o *BunBenchmark[K]) Aggregate(b *testing.B) {
+	aggregateBenchmark(b, func() error {
+		var stats []genreStats
+		return o.db.NewSelect().
+			Model((*model.Book[K])(nil)).
+			Column("genre").
+			ColumnExpr("COUNT(*) AS books").
+			ColumnExpr("SUM(quantity) AS total_quantity").
+			ColumnExpr("AVG(quantity) AS average_quantity").
+			Group("genre").
+			Scan(o.ctx, &stats)
+	})
+}
+
+func (o *BunBenchmark[K]) Search(b *testing.B) {
+	searchBenchmark(b, func(f searchFilter) error {
+		var books []model.Book[K]
+		query := o.db.NewSelect().Model(&books)
+		if f.AuthorPrefix != nil {
+			query = query.Where("author LIKE ?", *f.AuthorPrefix+"%")
 		}
-		b.StartTimer()
+		if f.Genre != nil {
+			query = query.Where("genre = ?", *f.Genre)
+		}
+		if f.PublishedAfter != nil {
+			query = query.Where("publicized_at >= ?", *f.PublishedAfter)
+		}
+		if f.PublishedBefore != nil {
+			query = query.Where("publicized_at < ?", *f.PublishedBefore)
+		}
+		if f.MinQuantity != nil {
+			query = query.Where("quantity >= ?", *f.MinQuantity)
+		}
+		if f.MaxQuantity != nil {
+			query = query.Where("quantity <= ?", *f.MaxQuantity)
+		}
+		if f.Descending {
+			query = query.OrderExpr("? DESC", bun.Ident(f.SortBy))
+		} else {
+			query = query.OrderExpr("? ASC", bun.Ident(f.SortBy))
+		}
+		return query.Order("id").Limit(utils.PageSize).Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark[K]) FindPageAt(pagination Pagination, depth int) func(b *testing.B) {
+	return paginationBenchmark(depth, func(depth int, cursor K) error {
+		var books []model.Book[K]
+		query := o.db.NewSelect().Model(&books).Order("id").Limit(utils.PageSize)
+		if pagination == Offset {
+			return query.Offset(depth).Scan(o.ctx)
+		}
+		return query.Where("id > ?", cursor).Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark[K]) FindTitles(b *testing.B) {
+	projectionBenchmark(b, func(cursor K) error {
+		var titles []bookTitle[K]
+		return o.db.NewSelect().
+			Model((*model.Book[K])(nil)).
+			Column("id", "title").
+			Where("id > ?", cursor).
+			Order("id").
+			Limit(utils.PageSize).
+			Scan(o.ctx, &titles)
+	})
+}
+
+func (o *BunBenchmark[K]) ScanLarge(mode ScanMode) func(b *testing.B) {
+	switch mode {
+	case Buffered:
+		return scanLargeBenchmark(func() error {
+			var books []model.Book[K]
+			return o.db.NewSelect().Model(&books).Scan(o.ctx)
+		})
+	case Streaming:
+		return scanLargeBenchmark(func() error {
+			rows, err := o.db.NewSelect().Model((*model.Book[K])(nil)).Rows(o.ctx)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = rows.Close()
+			}()
+
+			var book model.Book[K]
+			for rows.Next() {
+				if err = o.db.ScanRow(o.ctx, rows, &book); err != nil {
+					return err
+				}
+			}
+			return rows.Err()
+		})
 	}
+	return nil
+}
+
+func (o *BunBenchmark[K]) InsertNullable(b *testing.B) {
+	insertNullableBenchmark(b, func(book *model.NullableBook[K]) error {
+		_, err := o.db.NewInsert().Model(book).Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark[K]) FindNullable(b *testing.B) {
+	findNullableBenchmark(b, func(cursor K) error {
+		var books []model.NullableBook[K]
+		return o.db.NewSelect().Model(&books).Where("id > ?", cursor).Order("id").Limit(utils.PageSize).Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark[K]) UpdateNullable(b *testing.B) {
+	updateNullableBenchmark(b, func(book *model.NullableBook[K]) error {
+		_, err := o.db.NewUpdate().Model(book).WherePK().Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark[K]) InsertJSON(size DocumentSize) func(b *testing.B) {
+	return insertJSONBenchmark(size, func(book *model.JSONBook[K]) error {
+		_, err := o.db.NewInsert().Model(book).Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark[K]) FindJSON(size DocumentSize) func(b *testing.B) {
+	return findJSONBenchmark(size, func(cursor K) error {
+		var books []model.JSONBook[K]
+		return o.db.NewSelect().Model(&books).Where("id > ?", cursor).Order("id").Limit(utils.PageSize).Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark[K]) InsertTags(b *testing.B) {
+	insertTagsBenchmark(b, func(book *model.TaggedBook[K]) error {
+		_, err := o.db.NewInsert().Model(book).Exec(o.ctx)
+		return err
+	})
+}
+
+func (o *BunBenchmark[K]) FindTags(b *testing.B) {
+	findTagsBenchmark(b, func(cursor K) error {
+		var books []model.TaggedBook[K]
+		return o.db.NewSelect().Model(&books).Where("id > ?", cursor).Order("id").Limit(utils.PageSize).Scan(o.ctx)
+	})
+}
+
+func (o *BunBenchmark[K]) FilterTags(b *testing.B) {
+	filterTagsBenchmark[K](b, func(tags []string) error {
+		var books []model.TaggedBook[K]
+		return o.db.NewSelect().Model(&books).Where("tags @> ?", pgdialect.Array(tags)).Order("id").Limit(utils.PageSize).Scan(o.ctx)
+	})
 }
@@ -0,0 +1,186 @@
+package compare
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/environment"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/export"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/stats"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+)
+
+const (
+	NsPerOp     = "ns/op"
+	BytesPerOp  = "B/op"
+	AllocsPerOp = "allocs/op"
+)
+
+// Thresholds are the maximum accepted increase of each metric, as a ratio of the baseline (0.1 = +10%).
+type Thresholds map[string]float64
+
+// Delta is the change of a metric between the baseline and the current results.
+type Delta struct {
+	Orm       string
+	Operation string
+	Metric    string
+	Baseline  float64
+	Current   float64
+	// Change is the relative difference from the baseline, 0.1 meaning 10% higher.
+	Change float64
+	// Significant is false when the difference can be explained by noise. When any side has
+	// a single run, the significance can't be tested and Tested is false.
+	Significant bool
+	Tested      bool
+	// Regression is set when the change is significant (or untested) and exceeds the threshold.
+	Regression bool
+}
+
+// SaveBaseline stores the results as the named baseline inside dir.
+func SaveBaseline(dir, name string, config utils.Config, env environment.Environment, results []benchmark.ResultWrapper) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(export.NewDocument(config, env, results), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(baselinePath(dir, name), data, 0o644)
+}
+
+// LoadBaseline reads the named baseline from dir. A name ending in .json is read as a file path.
+func LoadBaseline(dir, name string) ([]benchmark.ResultWrapper, error) {
+	path := baselinePath(dir, name)
+	if strings.HasSuffix(name, ".json") {
+		path = name
+	}
+	doc, err := export.ReadDocument(path)
+	if err != nil {
+		return nil, err
+	}
+	return doc.Wrappers(), nil
+}
+
+func baselinePath(dir, name string) string {
+	return filepath.Join(dir, name+".json")
+}
+
+// Compare computes the deltas of every ORM and operation present in both baseline and current.
+func Compare(baseline, current []benchmark.ResultWrapper, thresholds Thresholds) []Delta {
+	var deltas []Delta
+	for _, c := range current {
+		idx := slices.IndexFunc(baseline, func(b benchmark.ResultWrapper) bool {
+			return b.Orm == c.Orm
+		})
+		if idx < 0 {
+			continue
+		}
+		base := baseline[idx]
+
+		for _, op := range sortedOperations(c) {
+			if _, ok := base.Benchmarks[op]; !ok {
+				continue
+			}
+			for _, metric := range []string{NsPerOp, BytesPerOp, AllocsPerOp} {
+				deltas = append(deltas, newDelta(c.Orm, op, metric,
+					values(base, op, metric), values(c, op, metric), thresholds[metric]))
+			}
+		}
+	}
+	slices.SortStableFunc(deltas, func(a, b Delta) int {
+		return strings.Compare(a.Orm, b.Orm)
+	})
+	return deltas
+}
+
+// HasRegression reports whether any delta is a regression.
+func HasRegression(deltas []Delta) bool {
+	return slices.ContainsFunc(deltas, func(d Delta) bool {
+		return d.Regression
+	})
+}
+
+// Write prints the deltas as a table.
+func Write(w io.Writer, deltas []Delta) {
+	table := new(tabwriter.Writer)
+	table.Init(w, 0, 8, 2, '\t', tabwriter.AlignRight)
+	_, _ = fmt.Fprint(table, "\n")
+	_, _ = fmt.Fprintln(table, "ORM\tOperation\tMetric\tBaseline\tCurrent\tChange\tResult\t")
+	for _, d := range deltas {
+		_, _ = fmt.Fprintf(table, "%s\t%s\t%s\t%.0f\t%.0f\t%+.1f%%\t%s\t\n",
+			d.Orm, d.Operation, d.Metric, d.Baseline, d.Current, d.Change*100, d.verdict())
+	}
+	_ = table.Flush()
+}
+
+func (d Delta) verdict() string {
+	switch {
+	case d.Regression:
+		return "REGRESSION"
+	case !d.Tested:
+		return "untested (single run)"
+	case !d.Significant:
+		return "no significant difference"
+	case d.Change < 0:
+		return "improvement"
+	default:
+		return "slower, within threshold"
+	}
+}
+
+func newDelta(orm, operation, metric string, baseline, current []float64, threshold float64) Delta {
+	d := Delta{
+		Orm:       orm,
+		Operation: operation,
+		Metric:    metric,
+		Baseline:  stats.Summarize(baseline).Mean,
+		Current:   stats.Summarize(current).Mean,
+		Tested:    len(baseline) > 1 && len(current) > 1,
+	}
+	if d.Baseline != 0 {
+		d.Change = d.Current/d.Baseline - 1
+	} else if d.Current != 0 {
+		d.Change = 1
+	}
+	d.Significant = stats.Significant(baseline, current)
+	d.Regression = (d.Significant || !d.Tested) && d.Change > threshold
+	return d
+}
+
+// values extracts the metric of every run of the operation.
+func values(r benchmark.ResultWrapper, operation, metric string) []float64 {
+	samples, ok := r.Samples[operation]
+	if !ok || len(samples) == 0 {
+		samples = []testing.BenchmarkResult{r.Benchmarks[operation]}
+	}
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		n := float64(max(s.N, 1))
+		switch metric {
+		case NsPerOp:
+			values[i] = float64(s.T.Nanoseconds()) / n
+		case BytesPerOp:
+			values[i] = float64(s.MemBytes) / n
+		case AllocsPerOp:
+			values[i] = float64(s.MemAllocs) / n
+		}
+	}
+	return values
+}
+
+func sortedOperations(r benchmark.ResultWrapper) []string {
+	operations := make([]string, 0, len(r.Benchmarks))
+	for op := range r.Benchmarks {
+		operations = append(operations, op)
+	}
+	slices.Sort(operations)
+	return operations
+}
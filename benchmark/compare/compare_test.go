@@ -0,0 +1,159 @@
+package compare
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark"
+)
+
+func TestNewDelta(t *testing.T) {
+	tests := []struct {
+		name           string
+		baseline       []float64
+		current        []float64
+		threshold      float64
+		wantChange     float64
+		wantTested     bool
+		wantRegression bool
+	}{
+		{
+			name:           "untested within threshold",
+			baseline:       []float64{100},
+			current:        []float64{105},
+			threshold:      0.1,
+			wantChange:     0.05,
+			wantRegression: false,
+		},
+		{
+			name:           "untested above threshold",
+			baseline:       []float64{100},
+			current:        []float64{120},
+			threshold:      0.1,
+			wantChange:     0.2,
+			wantRegression: true,
+		},
+		{
+			name:           "untested at threshold",
+			baseline:       []float64{100},
+			current:        []float64{125},
+			threshold:      0.25,
+			wantChange:     0.25,
+			wantRegression: false,
+		},
+		{
+			name:           "significant above threshold",
+			baseline:       []float64{100, 101, 99, 100, 100},
+			current:        []float64{130, 131, 129, 130, 130},
+			threshold:      0.1,
+			wantChange:     0.3,
+			wantTested:     true,
+			wantRegression: true,
+		},
+		{
+			name:           "significant within threshold",
+			baseline:       []float64{100, 101, 99, 100, 100},
+			current:        []float64{105, 106, 104, 105, 105},
+			threshold:      0.1,
+			wantChange:     0.05,
+			wantTested:     true,
+			wantRegression: false,
+		},
+		{
+			name:           "noise above threshold",
+			baseline:       []float64{100, 160, 40, 130, 70},
+			current:        []float64{120, 180, 60, 150, 90},
+			threshold:      0.1,
+			wantChange:     0.2,
+			wantTested:     true,
+			wantRegression: false,
+		},
+		{
+			name:           "significant improvement",
+			baseline:       []float64{100, 101, 99, 100, 100},
+			current:        []float64{50, 51, 49, 50, 50},
+			threshold:      0,
+			wantChange:     -0.5,
+			wantTested:     true,
+			wantRegression: false,
+		},
+		{
+			name:           "zero baseline",
+			baseline:       []float64{0, 0},
+			current:        []float64{3, 3},
+			threshold:      0.5,
+			wantChange:     1,
+			wantTested:     true,
+			wantRegression: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDelta("orm", "op", NsPerOp, tt.baseline, tt.current, tt.threshold)
+			if math.Abs(d.Change-tt.wantChange) > 1e-9 {
+				t.Errorf("Change = %v, want %v", d.Change, tt.wantChange)
+			}
+			if d.Tested != tt.wantTested {
+				t.Errorf("Tested = %v, want %v", d.Tested, tt.wantTested)
+			}
+			if d.Regression != tt.wantRegression {
+				t.Errorf("Regression = %v, want %v", d.Regression, tt.wantRegression)
+			}
+		})
+	}
+}
+
+func TestCompareThresholds(t *testing.T) {
+	result := func(ns, bytes int64) testing.BenchmarkResult {
+		return testing.BenchmarkResult{N: 1, T: time.Duration(ns), MemBytes: uint64(bytes)}
+	}
+	baseline := []benchmark.ResultWrapper{{
+		Orm:        "gorm",
+		Benchmarks: map[string]testing.BenchmarkResult{"insert": result(1000, 100)},
+	}}
+	current := []benchmark.ResultWrapper{{
+		Orm:        "gorm",
+		Benchmarks: map[string]testing.BenchmarkResult{"insert": result(1150, 130)},
+	}}
+
+	tests := []struct {
+		name       string
+		thresholds Thresholds
+		want       map[string]bool
+	}{
+		{
+			name:       "loose",
+			thresholds: Thresholds{NsPerOp: 0.2, BytesPerOp: 0.5},
+			want:       map[string]bool{NsPerOp: false, BytesPerOp: false, AllocsPerOp: false},
+		},
+		{
+			name:       "strict time",
+			thresholds: Thresholds{NsPerOp: 0.1, BytesPerOp: 0.5},
+			want:       map[string]bool{NsPerOp: true, BytesPerOp: false, AllocsPerOp: false},
+		},
+		{
+			name:       "strict memory",
+			thresholds: Thresholds{NsPerOp: 0.2, BytesPerOp: 0.2},
+			want:       map[string]bool{NsPerOp: false, BytesPerOp: true, AllocsPerOp: false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deltas := Compare(baseline, current, tt.thresholds)
+			if len(deltas) != len(tt.want) {
+				t.Fatalf("got %d deltas, want %d", len(deltas), len(tt.want))
+			}
+			regression := false
+			for _, d := range deltas {
+				if d.Regression != tt.want[d.Metric] {
+					t.Errorf("%s regression = %v, want %v", d.Metric, d.Regression, tt.want[d.Metric])
+				}
+				regression = regression || tt.want[d.Metric]
+			}
+			if got := HasRegression(deltas); got != regression {
+				t.Errorf("HasRegression = %v, want %v", got, regression)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package benchmark
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// LoadStrategy is a way of loading a page of books along with all of their price policies.
+type LoadStrategy string
+
+const (
+	// NPlusOne queries the page, then the policies of each book, one query at a time.
+	NPlusOne LoadStrategy = "n+1"
+	// Preload queries the page, then the policies of all of its books at once.
+	Preload LoadStrategy = "preload"
+	// Join queries the books and their policies together.
+	Join LoadStrategy = "join"
+)
+
+// LoadStrategies lists every strategy of FindPageWithPolicies.
+var LoadStrategies = []LoadStrategy{NPlusOne, Preload, Join}
+
+// bookPolicyRow is a row of a join between books and their price policies.
+type bookPolicyRow[K model.Key] struct {
+	ID           K
+	ISBN         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int
+	PublicizedAt time.Time
+	PolicyID     K
+	Price        float64
+	StartDate    time.Time
+	EndDate      time.Time
+}
+
+// eagerBenchmark seeds the books with their policies, then measures load fetching the page after
+// the cursor of the iteration, see cursorAt.
+func eagerBenchmark[K model.Key](load func(cursor K) error) func(b *testing.B) {
+	return func(b *testing.B) {
+		keys, err := seedPricedBooks[K](b.N, time.Now().UTC())
+		if err != nil {
+			b.Error(err)
+			return
+		}
+
+		run(b, func() step {
+			return step{
+				exec: func(i int) error {
+					return load(cursorAt(keys, i))
+				},
+			}
+		})
+	}
+}
+
+// groupPolicies folds join rows, sorted by book, into books holding their policies.
+func groupPolicies[K model.Key](rows []bookPolicyRow[K]) []model.Book[K] {
+	var books []model.Book[K]
+	for _, row := range rows {
+		if len(books) == 0 || books[len(books)-1].ID != row.ID {
+			books = append(books, model.Book[K]{
+				ID:           row.ID,
+				ISBN:         row.ISBN,
+				Title:        row.Title,
+				Author:       row.Author,
+				Genre:        row.Genre,
+				Quantity:     row.Quantity,
+				PublicizedAt: row.PublicizedAt,
+			})
+		}
+		book := &books[len(books)-1]
+		book.Policies = append(book.Policies, &model.PricePolicy[K]{
+			ID:        row.PolicyID,
+			BookID:    row.ID,
+			Price:     row.Price,
+			StartDate: row.StartDate,
+			EndDate:   row.EndDate,
+		})
+	}
+	return books
+}
+
+// attachPolicies assigns the policies to the books they belong to.
+func attachPolicies[K model.Key](books []model.Book[K], policies []*model.PricePolicy[K]) {
+	indexes := make(map[K]int, len(books))
+	for i := range books {
+		indexes[books[i].ID] = i
+	}
+	for _, policy := range policies {
+		if i, ok := indexes[policy.BookID]; ok {
+			books[i].Policies = append(books[i].Policies, policy)
+		}
+	}
+}
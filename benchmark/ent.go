@@ -4,9 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/jsonbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/nullablebook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/pricepolicy"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/taggedbook"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
 	"github.com/andreiac-silva/golang-orm-benchmarks/model"
 
@@ -22,6 +28,9 @@ type EntBenchmark struct {
 }
 
 func NewEntBenchmark() Benchmark {
+	if utils.PrimaryKey == utils.UUIDKey {
+		return &EntUUIDBenchmark{EntBenchmark: EntBenchmark{ctx: context.Background()}}
+	}
 	return &EntBenchmark{ctx: context.Background()}
 }
 
@@ -30,6 +39,7 @@ func (o *EntBenchmark) Init() error {
 	if err != nil {
 		return err
 	}
+	utils.ConfigurePool(db)
 	drv := entsql.OpenDB(dialect.Postgres, db)
 	o.db = ent.NewClient(ent.Driver(drv))
 	return nil
@@ -40,197 +50,566 @@ func (o *EntBenchmark) Close() error {
 }
 
 func (o *EntBenchmark) Insert(b *testing.B) {
-	newBook := model.NewBook()
-
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		newBook.ID = 0
-		b.StartTimer()
-
-		_, err := o.db.Book.
-			Create().
-			SetIsbn(newBook.ISBN).
-			SetTitle(newBook.Title).
-			SetAuthor(newBook.Author).
-			SetGenre(newBook.Genre).
-			SetQuantity(newBook.Quantity).
-			SetPublicizedAt(newBook.PublicizedAt).
-			Save(o.ctx)
-
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	newBook := model.NewBook[int64]()
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				_, err := setBook(o.db.Book.Create(), newBook).Save(o.ctx)
+				return err
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
 func (o *EntBenchmark) InsertBulk(b *testing.B) {
-	books := model.NewBooks(utils.BulkInsertNumber)
-
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	batch := make([]*ent.BookCreate, len(books))
-	for i, newBook := range books {
-		batch[i] = o.db.Book.Create().
-			SetIsbn(newBook.ISBN).
-			SetTitle(newBook.Title).
-			SetAuthor(newBook.Author).
-			SetGenre(newBook.Genre).
-			SetQuantity(newBook.Quantity).
-			SetPublicizedAt(newBook.PublicizedAt)
-	}
+	books := model.NewBooks[int64](utils.BulkInsertNumber)
 
-	for i := 0; i < b.N; i++ {
-		_, err := o.db.Book.CreateBulk(batch...).Save(o.ctx)
+	run(b, func() step {
+		batch := make([]*ent.BookCreate, len(books))
+		for i, newBook := range books {
+			batch[i] = setBook(o.db.Book.Create(), newBook)
+		}
+		return step{
+			exec: func(int) error {
+				return inStatements(batch, func(chunk []*ent.BookCreate) error {
+					_, err := o.db.Book.CreateBulk(chunk...).Save(o.ctx)
+					return err
+				})
+			},
+		}
+	})
+}
 
-		b.StopTimer()
+func (o *EntBenchmark) Update(b *testing.B) {
+	run(b, func() step {
+		newBook := model.NewBook[int64]()
+		saved, err := setBook(o.db.Book.Create(), newBook).Save(o.ctx)
 		if err != nil {
 			b.Error(err)
+			return step{exec: func(int) error { return err }}
 		}
-		b.StartTimer()
+		return step{
+			exec: func(int) error {
+				_, err := setBook(o.db.Book.UpdateOneID(saved.ID), newBook).Save(o.ctx)
+				return err
+			},
+		}
+	})
+}
+
+// Delete seeds the books with COPY, as a single bulk insert through Ent would exceed the bind
+// parameter limit of PostgreSQL, and Ent only reads back the keys of a bulk insert when they are
+// integers.
+func (o *EntBenchmark) Delete(b *testing.B) {
+	keys, err := seedKeyedBooks[int64](b.N)
+	if err != nil {
+		b.Error(err)
+		return
 	}
 
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				return o.db.Book.
+					DeleteOneID(int(keys[i])).
+					Exec(o.ctx)
+			},
+		}
+	})
 }
 
-func (o *EntBenchmark) Update(b *testing.B) {
-	newBook := model.NewBook()
-
-	saved, err := o.db.Book.
-		Create().
-		SetIsbn(newBook.ISBN).
-		SetTitle(newBook.Title).
-		SetAuthor(newBook.Author).
-		SetGenre(newBook.Genre).
-		SetQuantity(newBook.Quantity).
-		SetPublicizedAt(newBook.PublicizedAt).
-		Save(o.ctx)
+// FindByID seeds the books with COPY, see Delete.
+func (o *EntBenchmark) FindByID(b *testing.B) {
+	keys, err := seedKeyedBooks[int64](b.N)
 	if err != nil {
 		b.Error(err)
+		return
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		_, err = o.db.Book.
-			UpdateOneID(saved.ID).
-			SetIsbn(newBook.ISBN).
-			SetTitle(newBook.Title).
-			SetAuthor(newBook.Author).
-			SetGenre(newBook.Genre).
-			SetQuantity(newBook.Quantity).
-			SetPublicizedAt(newBook.PublicizedAt).
-			Save(o.ctx)
-
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := o.db.Book.Get(o.ctx, int(keys[i]))
+				return err
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
-func (o *EntBenchmark) Delete(b *testing.B) {
-	n := b.N
-	books := model.NewBooks(n)
-	batch := make([]*ent.BookCreate, len(books))
-	for i, newBook := range books {
-		batch[i] = o.db.Book.Create().
-			SetIsbn(newBook.ISBN).
-			SetTitle(newBook.Title).
-			SetAuthor(newBook.Author).
-			SetGenre(newBook.Genre).
-			SetQuantity(newBook.Quantity).
-			SetPublicizedAt(newBook.PublicizedAt)
+// FindPage seeds the books with COPY, see Delete.
+func (o *EntBenchmark) FindPage(b *testing.B) {
+	keys, err := seedKeyedBooks[int64](b.N)
+	if err != nil {
+		b.Error(err)
+		return
 	}
 
-	saved, err := o.db.Book.CreateBulk(batch...).Save(o.ctx)
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := o.db.Book.
+					Query().
+					Where(book.IDGT(int(cursorAt(keys, i)))).
+					Order(ent.Asc(book.FieldID)).
+					Limit(utils.PageSize).
+					All(o.ctx)
+				return err
+			},
+		}
+	})
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+func (o *EntBenchmark) FindWithActivePolicy(b *testing.B) {
+	now := time.Now().UTC()
+	keys, err := seedPricedBooks[int64](b.N, now)
+	if err != nil {
+		b.Error(err)
+		return
+	}
 
-	for i := 0; i < n; i++ {
-		err = o.db.Book.
-			DeleteOneID(saved[i].ID).
-			Exec(o.ctx)
+	active := func(q *ent.PricePolicyQuery) {
+		q.Where(pricepolicy.StartDateLTE(now), pricepolicy.EndDateGT(now))
+	}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := o.db.Book.
+					Query().
+					Where(
+						book.IDGT(int(cursorAt(keys, i))),
+						book.HasPoliciesWith(pricepolicy.StartDateLTE(now), pricepolicy.EndDateGT(now)),
+					).
+					WithPolicies(active).
+					Order(ent.Asc(book.FieldID)).
+					Limit(utils.PageSize).
+					All(o.ctx)
+				return err
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
-func (o *EntBenchmark) FindByID(b *testing.B) {
-	n := b.N
-	books := model.NewBooks(n)
-	batch := make([]*ent.BookCreate, len(books))
-	for i, newBook := range books {
-		batch[i] = o.db.Book.Create().
-			SetIsbn(newBook.ISBN).
-			SetTitle(newBook.Title).
-			SetAuthor(newBook.Author).
-			SetGenre(newBook.Genre).
-			SetQuantity(newBook.Quantity).
-			SetPublicizedAt(newBook.PublicizedAt)
+// FindPageWithPolicies has no join strategy, as Ent always loads edges with separate queries.
+func (o *EntBenchmark) FindPageWithPolicies(strategy LoadStrategy) func(b *testing.B) {
+	switch strategy {
+	case NPlusOne:
+		return eagerBenchmark(func(cursor int64) error {
+			books, err := o.db.Book.Query().Where(book.IDGT(int(cursor))).Order(ent.Asc(book.FieldID)).Limit(utils.PageSize).All(o.ctx)
+			if err != nil {
+				return err
+			}
+			for _, found := range books {
+				found.Edges.Policies, err = found.QueryPolicies().Order(ent.Asc(pricepolicy.FieldID)).All(o.ctx)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	case Preload:
+		return eagerBenchmark(func(cursor int64) error {
+			_, err := o.db.Book.Query().
+				Where(book.IDGT(int(cursor))).
+				WithPolicies().
+				Order(ent.Asc(book.FieldID)).
+				Limit(utils.PageSize).
+				All(o.ctx)
+			return err
+		})
 	}
+	return nil
+}
 
-	saved, err := o.db.Book.CreateBulk(batch...).Save(o.ctx)
-
-	b.ReportAllocs()
-	b.ResetTimer()
+func (o *EntBenchmark) Transaction(b *testing.B) {
+	o.transaction(b, false)
+}
 
-	var bookID int
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		bookID = saved[i].ID
-		b.StartTimer()
+func (o *EntBenchmark) TransactionRollback(b *testing.B) {
+	o.transaction(b, true)
+}
 
-		_, err = o.db.Book.Get(o.ctx, bookID)
+func (o *EntBenchmark) transaction(b *testing.B, rollback bool) {
+	now := time.Now().UTC()
+	newBook := model.NewBook[int64]()
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				tx, err := o.db.Tx(o.ctx)
+				if err != nil {
+					return err
+				}
+
+				saved, err := setBook(tx.Book.Create(), newBook).Save(o.ctx)
+				if err != nil {
+					_ = tx.Rollback()
+					return err
+				}
+
+				policies := model.NewPricePolicies(int64(saved.ID), now)
+				batch := make([]*ent.PricePolicyCreate, len(policies))
+				for i, policy := range policies {
+					batch[i] = tx.PricePolicy.Create().
+						SetBookID(saved.ID).
+						SetPrice(policy.Price).
+						SetStartDate(policy.StartDate).
+						SetEndDate(policy.EndDate)
+				}
+				if _, err = tx.PricePolicy.CreateBulk(batch...).Save(o.ctx); err != nil {
+					_ = tx.Rollback()
+					return err
+				}
+
+				if rollback {
+					return tx.Rollback()
+				}
+				return tx.Commit()
+			},
+		}
+	})
+}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+func (o *EntBenchmark) Upsert(b *testing.B) {
+	upsertBenchmark(b, func(books []*model.Book[int64]) error {
+		batch := make([]*ent.BookCreate, len(books))
+		for i, newBook := range books {
+			batch[i] = setBook(o.db.Book.Create(), newBook)
 		}
-		b.StartTimer()
+		return inStatements(batch, func(chunk []*ent.BookCreate) error {
+			return o.db.Book.CreateBulk(chunk...).
+				OnConflictColumns(book.FieldIsbn).
+				UpdateQuantity().
+				Exec(o.ctx)
+		})
+	})
+}
+
+func (o *EntBenchmark) UpdateBulk(affected int) func(b *testing.B) {
+	return updateBulkBenchmark(affected, func(genre string, quantity int) error {
+		_, err := o.db.Book.Update().Where(book.GenreEQ(genre)).SetQuantity(quantity).Save(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) DeleteBulk(affected int) func(b *testing.B) {
+	return deleteBulkBenchmark(affected, func(genre string) error {
+		_, err := o.db.Book.Delete().Where(book.GenreEQ(genre)).Exec(o.ctx)
+		return err
+	})
+}
+
+// FindByIDs builds the AnyArray predicate by hand, as Ent only generates IN lists.
+func (o *EntBenchmark) FindByIDs(list IDList) func(b *testing.B) {
+	switch list {
+	case InList:
+		return manyIDsBenchmark(func(ids []int64) error {
+			intIDs := make([]int, len(ids))
+			for i, id := range ids {
+				intIDs[i] = int(id)
+			}
+			_, err := o.db.Book.Query().Where(book.IDIn(intIDs...)).All(o.ctx)
+			return err
+		})
+	case AnyArray:
+		return manyIDsBenchmark(func(ids []int64) error {
+			_, err := o.db.Book.Query().Where(func(s *entsql.Selector) {
+				s.Where(entsql.P(func(b *entsql.Builder) {
+					b.Ident(s.C(book.FieldID)).WriteString(" = ANY(").Arg(ids).WriteString(")")
+				}))
+			}).All(o.ctx)
+			return err
+		})
 	}
+	return nil
 }
 
-func (o *EntBenchmark) FindPage(b *testing.B) {
-	n := b.N
-	books := model.NewBooks(n)
-	batch := make([]*ent.BookCreate, len(books))
-	for i, newBook := range books {
-		batch[i] = o.db.Book.Create().
-			SetIsbn(newBook.ISBN).
-			SetTitle(newBook.Title).
-			SetAuthor(newBook.Author).
-			SetGenre(newBook.Genre).
-			SetQuantity(newBook.Quantity).
-			SetPublicizedAt(newBook.PublicizedAt)
+func (o *EntBenchmark) Aggregate(b *testing.B) {
+	aggregateBenchmark(b, func() error {
+		var stats []genreStats
+		return o.db.Book.Query().
+			GroupBy(book.FieldGenre).
+			Aggregate(
+				ent.As(ent.Count(), "books"),
+				ent.As(ent.Sum(book.FieldQuantity), "total_quantity"),
+				ent.As(ent.Mean(book.FieldQuantity), "average_quantity"),
+			).
+			Scan(o.ctx, &stats)
+	})
+}
+
+func (o *EntBenchmark) Search(b *testing.B) {
+	searchBenchmark(b, func(f searchFilter) error {
+		var predicates []predicate.Book
+		if f.AuthorPrefix != nil {
+			predicates = append(predicates, book.AuthorHasPrefix(*f.AuthorPrefix))
+		}
+		if f.Genre != nil {
+			predicates = append(predicates, book.GenreEQ(*f.Genre))
+		}
+		if f.PublishedAfter != nil {
+			predicates = append(predicates, book.PublicizedAtGTE(*f.PublishedAfter))
+		}
+		if f.PublishedBefore != nil {
+			predicates = append(predicates, book.PublicizedAtLT(*f.PublishedBefore))
+		}
+		if f.MinQuantity != nil {
+			predicates = append(predicates, book.QuantityGTE(*f.MinQuantity))
+		}
+		if f.MaxQuantity != nil {
+			predicates = append(predicates, book.QuantityLTE(*f.MaxQuantity))
+		}
+		order := ent.Asc(f.SortBy)
+		if f.Descending {
+			order = ent.Desc(f.SortBy)
+		}
+
+		_, err := o.db.Book.Query().
+			Where(predicates...).
+			Order(order, ent.Asc(book.FieldID)).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) FindPageAt(pagination Pagination, depth int) func(b *testing.B) {
+	return paginationBenchmark(depth, func(depth int, cursor int64) error {
+		query := o.db.Book.Query().Order(ent.Asc(book.FieldID)).Limit(utils.PageSize)
+		if pagination == Offset {
+			query = query.Offset(depth)
+		} else {
+			query = query.Where(book.IDGT(int(cursor)))
+		}
+		_, err := query.All(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) FindTitles(b *testing.B) {
+	projectionBenchmark(b, func(cursor int64) error {
+		var titles []bookTitle[int64]
+		return o.db.Book.Query().
+			Where(book.IDGT(int(cursor))).
+			Order(ent.Asc(book.FieldID)).
+			Limit(utils.PageSize).
+			Select(book.FieldID, book.FieldTitle).
+			Scan(o.ctx, &titles)
+	})
+}
+
+// ScanLarge has no streaming mode, as Ent always materializes the results of a query.
+func (o *EntBenchmark) ScanLarge(mode ScanMode) func(b *testing.B) {
+	if mode != Buffered {
+		return nil
 	}
-	_, err := o.db.Book.CreateBulk(batch...).Save(o.ctx)
+	return scanLargeBenchmark(func() error {
+		_, err := o.db.Book.Query().All(o.ctx)
+		return err
+	})
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+func (o *EntBenchmark) InsertNullable(b *testing.B) {
+	insertNullableBenchmark(b, func(book *model.NullableBook[int64]) error {
+		_, err := setNullableBook(o.db.NullableBook.Create(), book).Save(o.ctx)
+		return err
+	})
+}
 
-	for i := 0; i < n; i++ {
-		_, err = o.db.Book.
+func (o *EntBenchmark) FindNullable(b *testing.B) {
+	findNullableBenchmark(b, func(cursor int64) error {
+		_, err := o.db.NullableBook.
 			Query().
-			Where(book.IDGT(i)).
+			Where(nullablebook.IDGT(int(cursor))).
+			Order(ent.Asc(nullablebook.FieldID)).
 			Limit(utils.PageSize).
 			All(o.ctx)
+		return err
+	})
+}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
-		}
-		b.StartTimer()
+func (o *EntBenchmark) UpdateNullable(b *testing.B) {
+	updateNullableBenchmark(b, func(book *model.NullableBook[int64]) error {
+		_, err := updateNullableBook(o.db.NullableBook.UpdateOneID(int(book.ID)), book).Save(o.ctx)
+		return err
+	})
+}
+
+// nullStringPtr converts s into the pointer Ent takes for nillable fields.
+func nullStringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// entBook is implemented by the builders Ent generates to create or update a book, of both key
+// variants, whose setters return the builder.
+type entBook[T any] interface {
+	SetIsbn(string) T
+	SetTitle(string) T
+	SetAuthor(string) T
+	SetGenre(string) T
+	SetQuantity(int) T
+	SetPublicizedAt(time.Time) T
+}
+
+// setBook sets the fields of the book on the builder.
+func setBook[T entBook[T], K model.Key](builder T, book *model.Book[K]) T {
+	return builder.
+		SetIsbn(book.ISBN).
+		SetTitle(book.Title).
+		SetAuthor(book.Author).
+		SetGenre(book.Genre).
+		SetQuantity(book.Quantity).
+		SetPublicizedAt(book.PublicizedAt)
+}
+
+type entNullableBookCreate[T any] interface {
+	entBook[T]
+	SetNillableSubtitle(*string) T
+	SetNillableDescription(*string) T
+	SetNillableDiscontinuedAt(*time.Time) T
+}
+
+// setNullableBook sets the fields of the book on the builder, leaving those without a value NULL.
+func setNullableBook[T entNullableBookCreate[T], K model.Key](builder T, book *model.NullableBook[K]) T {
+	return builder.
+		SetIsbn(book.ISBN).
+		SetTitle(book.Title).
+		SetNillableSubtitle(book.Subtitle).
+		SetNillableDescription(nullStringPtr(book.Description)).
+		SetAuthor(book.Author).
+		SetGenre(book.Genre).
+		SetQuantity(book.Quantity).
+		SetPublicizedAt(book.PublicizedAt).
+		SetNillableDiscontinuedAt(book.DiscontinuedAt)
+}
+
+type entNullableBookUpdate[T any] interface {
+	entBook[T]
+	SetSubtitle(string) T
+	ClearSubtitle() T
+	SetDescription(string) T
+	ClearDescription() T
+	SetDiscontinuedAt(time.Time) T
+	ClearDiscontinuedAt() T
+}
+
+// updateNullableBook sets the fields of the book on the builder, clearing those without a value
+// explicitly, as the SetNillable setters skip nil values.
+func updateNullableBook[T entNullableBookUpdate[T], K model.Key](builder T, book *model.NullableBook[K]) T {
+	builder = builder.
+		SetIsbn(book.ISBN).
+		SetTitle(book.Title).
+		SetAuthor(book.Author).
+		SetGenre(book.Genre).
+		SetQuantity(book.Quantity).
+		SetPublicizedAt(book.PublicizedAt)
+	if book.Subtitle != nil {
+		builder = builder.SetSubtitle(*book.Subtitle)
+	} else {
+		builder = builder.ClearSubtitle()
+	}
+	if book.Description.Valid {
+		builder = builder.SetDescription(book.Description.String)
+	} else {
+		builder = builder.ClearDescription()
 	}
+	if book.DiscontinuedAt != nil {
+		builder = builder.SetDiscontinuedAt(*book.DiscontinuedAt)
+	} else {
+		builder = builder.ClearDiscontinuedAt()
+	}
+	return builder
+}
+
+type entJSONBook[T any] interface {
+	entBook[T]
+	SetMetadata(model.BookMetadata) T
+}
+
+// setJSONBook sets the fields of the book on the builder.
+func setJSONBook[T entJSONBook[T], K model.Key](builder T, book *model.JSONBook[K]) T {
+	return builder.
+		SetIsbn(book.ISBN).
+		SetTitle(book.Title).
+		SetAuthor(book.Author).
+		SetGenre(book.Genre).
+		SetQuantity(book.Quantity).
+		SetPublicizedAt(book.PublicizedAt).
+		SetMetadata(book.Metadata)
+}
+
+type entTaggedBook[T any] interface {
+	entBook[T]
+	SetTags(model.TextArray) T
+}
+
+// setTaggedBook sets the fields of the book on the builder, converting the tags to a TextArray.
+func setTaggedBook[T entTaggedBook[T], K model.Key](builder T, book *model.TaggedBook[K]) T {
+	return builder.
+		SetIsbn(book.ISBN).
+		SetTitle(book.Title).
+		SetAuthor(book.Author).
+		SetGenre(book.Genre).
+		SetQuantity(book.Quantity).
+		SetPublicizedAt(book.PublicizedAt).
+		SetTags(model.TextArray(book.Tags))
+}
+
+func (o *EntBenchmark) InsertJSON(size DocumentSize) func(b *testing.B) {
+	return insertJSONBenchmark(size, func(book *model.JSONBook[int64]) error {
+		_, err := setJSONBook(o.db.JSONBook.Create(), book).Save(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) FindJSON(size DocumentSize) func(b *testing.B) {
+	return findJSONBenchmark(size, func(cursor int64) error {
+		_, err := o.db.JSONBook.
+			Query().
+			Where(jsonbook.IDGT(int(cursor))).
+			Order(ent.Asc(jsonbook.FieldID)).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) InsertTags(b *testing.B) {
+	insertTagsBenchmark(b, func(book *model.TaggedBook[int64]) error {
+		_, err := setTaggedBook(o.db.TaggedBook.Create(), book).Save(o.ctx)
+		return err
+	})
+}
+
+func (o *EntBenchmark) FindTags(b *testing.B) {
+	findTagsBenchmark(b, func(cursor int64) error {
+		_, err := o.db.TaggedBook.
+			Query().
+			Where(taggedbook.IDGT(int(cursor))).
+			Order(ent.Asc(taggedbook.FieldID)).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
+}
+
+// FilterTags builds the @> predicate by hand, as Ent has no array operators.
+func (o *EntBenchmark) FilterTags(b *testing.B) {
+	filterTagsBenchmark[int64](b, func(tags []string) error {
+		_, err := o.db.TaggedBook.
+			Query().
+			Where(func(s *entsql.Selector) {
+				s.Where(entsql.P(func(b *entsql.Builder) {
+					b.Ident(s.C(taggedbook.FieldTags)).WriteString(" @> ").Arg(model.TextArray(tags))
+				}))
+			}).
+			Order(ent.Asc(taggedbook.FieldID)).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
 }
@@ -29,9 +29,30 @@ type Book struct {
 	Quantity int `json:"quantity,omitempty"`
 	// PublicizedAt holds the value of the "publicized_at" field.
 	PublicizedAt time.Time `json:"publicized_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookQuery when eager-loading is set.
+	Edges        BookEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// BookEdges holds the relations/edges for other nodes in the graph.
+type BookEdges struct {
+	// Policies holds the value of the policies edge.
+	Policies []*PricePolicy `json:"policies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PoliciesOrErr returns the Policies value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) PoliciesOrErr() ([]*PricePolicy, error) {
+	if e.loadedTypes[0] {
+		return e.Policies, nil
+	}
+	return nil, &NotLoadedError{edge: "policies"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Book) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -113,6 +134,11 @@ func (b *Book) Value(name string) (ent.Value, error) {
 	return b.selectValues.Get(name)
 }
 
+// QueryPolicies queries the "policies" edge of the Book entity.
+func (b *Book) QueryPolicies() *PricePolicyQuery {
+	return NewBookClient(b.config).QueryPolicies(b)
+}
+
 // Update returns a builder for updating this Book.
 // Note that you need to call Book.Unwrap() before calling this method if this Book
 // was returned from a transaction, and the transaction was committed or rolled back.
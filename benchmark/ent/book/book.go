@@ -4,6 +4,7 @@ package book
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -23,8 +24,17 @@ const (
 	FieldQuantity = "quantity"
 	// FieldPublicizedAt holds the string denoting the publicized_at field in the database.
 	FieldPublicizedAt = "publicized_at"
+	// EdgePolicies holds the string denoting the policies edge name in mutations.
+	EdgePolicies = "policies"
 	// Table holds the table name of the book in the database.
 	Table = "books"
+	// PoliciesTable is the table that holds the policies relation/edge.
+	PoliciesTable = "price_policies"
+	// PoliciesInverseTable is the table name for the PricePolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "pricepolicy" package.
+	PoliciesInverseTable = "price_policies"
+	// PoliciesColumn is the table column denoting the policies relation/edge.
+	PoliciesColumn = "book_id"
 )
 
 // Columns holds all SQL columns for book fields.
@@ -85,3 +95,24 @@ func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 func ByPublicizedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublicizedAt, opts...).ToFunc()
 }
+
+// ByPoliciesCount orders the results by policies count.
+func ByPoliciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPoliciesStep(), opts...)
+	}
+}
+
+// ByPolicies orders the results by policies terms.
+func ByPolicies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPoliciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPoliciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PoliciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PoliciesTable, PoliciesColumn),
+	)
+}
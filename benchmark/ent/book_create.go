@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/pricepolicy"
 )
 
 // BookCreate is the builder for creating a Book entity.
@@ -18,6 +20,7 @@ type BookCreate struct {
 	config
 	mutation *BookMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetIsbn sets the "isbn" field.
@@ -56,6 +59,21 @@ func (bc *BookCreate) SetPublicizedAt(t time.Time) *BookCreate {
 	return bc
 }
 
+// AddPolicyIDs adds the "policies" edge to the PricePolicy entity by IDs.
+func (bc *BookCreate) AddPolicyIDs(ids ...int) *BookCreate {
+	bc.mutation.AddPolicyIDs(ids...)
+	return bc
+}
+
+// AddPolicies adds the "policies" edges to the PricePolicy entity.
+func (bc *BookCreate) AddPolicies(p ...*PricePolicy) *BookCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bc.AddPolicyIDs(ids...)
+}
+
 // Mutation returns the BookMutation object of the builder.
 func (bc *BookCreate) Mutation() *BookMutation {
 	return bc.mutation
@@ -134,6 +152,7 @@ func (bc *BookCreate) createSpec() (*Book, *sqlgraph.CreateSpec) {
 		_node = &Book{config: bc.config}
 		_spec = sqlgraph.NewCreateSpec(book.Table, sqlgraph.NewFieldSpec(book.FieldID, field.TypeInt))
 	)
+	_spec.OnConflict = bc.conflict
 	if value, ok := bc.mutation.Isbn(); ok {
 		_spec.SetField(book.FieldIsbn, field.TypeString, value)
 		_node.Isbn = value
@@ -158,14 +177,322 @@ func (bc *BookCreate) createSpec() (*Book, *sqlgraph.CreateSpec) {
 		_spec.SetField(book.FieldPublicizedAt, field.TypeTime, value)
 		_node.PublicizedAt = value
 	}
+	if nodes := bc.mutation.PoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PoliciesTable,
+			Columns: []string{book.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Book.Create().
+//		SetIsbn(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BookUpsert) {
+//			SetIsbn(v+v).
+//		}).
+//		Exec(ctx)
+func (bc *BookCreate) OnConflict(opts ...sql.ConflictOption) *BookUpsertOne {
+	bc.conflict = opts
+	return &BookUpsertOne{
+		create: bc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Book.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bc *BookCreate) OnConflictColumns(columns ...string) *BookUpsertOne {
+	bc.conflict = append(bc.conflict, sql.ConflictColumns(columns...))
+	return &BookUpsertOne{
+		create: bc,
+	}
+}
+
+type (
+	// BookUpsertOne is the builder for "upsert"-ing
+	//  one Book node.
+	BookUpsertOne struct {
+		create *BookCreate
+	}
+
+	// BookUpsert is the "OnConflict" setter.
+	BookUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetIsbn sets the "isbn" field.
+func (u *BookUpsert) SetIsbn(v string) *BookUpsert {
+	u.Set(book.FieldIsbn, v)
+	return u
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *BookUpsert) UpdateIsbn() *BookUpsert {
+	u.SetExcluded(book.FieldIsbn)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *BookUpsert) SetTitle(v string) *BookUpsert {
+	u.Set(book.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *BookUpsert) UpdateTitle() *BookUpsert {
+	u.SetExcluded(book.FieldTitle)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *BookUpsert) SetAuthor(v string) *BookUpsert {
+	u.Set(book.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *BookUpsert) UpdateAuthor() *BookUpsert {
+	u.SetExcluded(book.FieldAuthor)
+	return u
+}
+
+// SetGenre sets the "genre" field.
+func (u *BookUpsert) SetGenre(v string) *BookUpsert {
+	u.Set(book.FieldGenre, v)
+	return u
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *BookUpsert) UpdateGenre() *BookUpsert {
+	u.SetExcluded(book.FieldGenre)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *BookUpsert) SetQuantity(v int) *BookUpsert {
+	u.Set(book.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *BookUpsert) UpdateQuantity() *BookUpsert {
+	u.SetExcluded(book.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *BookUpsert) AddQuantity(v int) *BookUpsert {
+	u.Add(book.FieldQuantity, v)
+	return u
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *BookUpsert) SetPublicizedAt(v time.Time) *BookUpsert {
+	u.Set(book.FieldPublicizedAt, v)
+	return u
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *BookUpsert) UpdatePublicizedAt() *BookUpsert {
+	u.SetExcluded(book.FieldPublicizedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Book.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BookUpsertOne) UpdateNewValues() *BookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Book.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BookUpsertOne) Ignore() *BookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BookUpsertOne) DoNothing() *BookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BookCreate.OnConflict
+// documentation for more info.
+func (u *BookUpsertOne) Update(set func(*BookUpsert)) *BookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetIsbn sets the "isbn" field.
+func (u *BookUpsertOne) SetIsbn(v string) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetIsbn(v)
+	})
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateIsbn() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateIsbn()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *BookUpsertOne) SetTitle(v string) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateTitle() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *BookUpsertOne) SetAuthor(v string) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateAuthor() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetGenre sets the "genre" field.
+func (u *BookUpsertOne) SetGenre(v string) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetGenre(v)
+	})
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateGenre() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateGenre()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *BookUpsertOne) SetQuantity(v int) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *BookUpsertOne) AddQuantity(v int) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdateQuantity() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *BookUpsertOne) SetPublicizedAt(v time.Time) *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.SetPublicizedAt(v)
+	})
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *BookUpsertOne) UpdatePublicizedAt() *BookUpsertOne {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdatePublicizedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BookUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BookCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BookUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BookUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BookUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // BookCreateBulk is the builder for creating many Book entities in bulk.
 type BookCreateBulk struct {
 	config
 	err      error
 	builders []*BookCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Book entities in the database.
@@ -194,6 +521,7 @@ func (bcb *BookCreateBulk) Save(ctx context.Context) ([]*Book, error) {
 					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -247,3 +575,198 @@ func (bcb *BookCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Book.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BookUpsert) {
+//			SetIsbn(v+v).
+//		}).
+//		Exec(ctx)
+func (bcb *BookCreateBulk) OnConflict(opts ...sql.ConflictOption) *BookUpsertBulk {
+	bcb.conflict = opts
+	return &BookUpsertBulk{
+		create: bcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Book.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bcb *BookCreateBulk) OnConflictColumns(columns ...string) *BookUpsertBulk {
+	bcb.conflict = append(bcb.conflict, sql.ConflictColumns(columns...))
+	return &BookUpsertBulk{
+		create: bcb,
+	}
+}
+
+// BookUpsertBulk is the builder for "upsert"-ing
+// a bulk of Book nodes.
+type BookUpsertBulk struct {
+	create *BookCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Book.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BookUpsertBulk) UpdateNewValues() *BookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Book.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BookUpsertBulk) Ignore() *BookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BookUpsertBulk) DoNothing() *BookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BookCreateBulk.OnConflict
+// documentation for more info.
+func (u *BookUpsertBulk) Update(set func(*BookUpsert)) *BookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetIsbn sets the "isbn" field.
+func (u *BookUpsertBulk) SetIsbn(v string) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetIsbn(v)
+	})
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateIsbn() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateIsbn()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *BookUpsertBulk) SetTitle(v string) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateTitle() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *BookUpsertBulk) SetAuthor(v string) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateAuthor() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetGenre sets the "genre" field.
+func (u *BookUpsertBulk) SetGenre(v string) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetGenre(v)
+	})
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateGenre() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateGenre()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *BookUpsertBulk) SetQuantity(v int) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *BookUpsertBulk) AddQuantity(v int) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdateQuantity() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *BookUpsertBulk) SetPublicizedAt(v time.Time) *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.SetPublicizedAt(v)
+	})
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *BookUpsertBulk) UpdatePublicizedAt() *BookUpsertBulk {
+	return u.Update(func(s *BookUpsert) {
+		s.UpdatePublicizedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BookUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BookCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BookCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BookUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
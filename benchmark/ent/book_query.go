@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,15 +13,17 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/book"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/pricepolicy"
 )
 
 // BookQuery is the builder for querying Book entities.
 type BookQuery struct {
 	config
-	ctx        *QueryContext
-	order      []book.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Book
+	ctx          *QueryContext
+	order        []book.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Book
+	withPolicies *PricePolicyQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -57,6 +60,28 @@ func (bq *BookQuery) Order(o ...book.OrderOption) *BookQuery {
 	return bq
 }
 
+// QueryPolicies chains the current query on the "policies" edge.
+func (bq *BookQuery) QueryPolicies() *PricePolicyQuery {
+	query := (&PricePolicyClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(pricepolicy.Table, pricepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.PoliciesTable, book.PoliciesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Book entity from the query.
 // Returns a *NotFoundError when no Book was found.
 func (bq *BookQuery) First(ctx context.Context) (*Book, error) {
@@ -244,17 +269,29 @@ func (bq *BookQuery) Clone() *BookQuery {
 		return nil
 	}
 	return &BookQuery{
-		config:     bq.config,
-		ctx:        bq.ctx.Clone(),
-		order:      append([]book.OrderOption{}, bq.order...),
-		inters:     append([]Interceptor{}, bq.inters...),
-		predicates: append([]predicate.Book{}, bq.predicates...),
+		config:       bq.config,
+		ctx:          bq.ctx.Clone(),
+		order:        append([]book.OrderOption{}, bq.order...),
+		inters:       append([]Interceptor{}, bq.inters...),
+		predicates:   append([]predicate.Book{}, bq.predicates...),
+		withPolicies: bq.withPolicies.Clone(),
 		// clone intermediate query.
 		sql:  bq.sql.Clone(),
 		path: bq.path,
 	}
 }
 
+// WithPolicies tells the query-builder to eager-load the nodes that are connected to
+// the "policies" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BookQuery) WithPolicies(opts ...func(*PricePolicyQuery)) *BookQuery {
+	query := (&PricePolicyClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withPolicies = query
+	return bq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -331,8 +368,11 @@ func (bq *BookQuery) prepareQuery(ctx context.Context) error {
 
 func (bq *BookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Book, error) {
 	var (
-		nodes = []*Book{}
-		_spec = bq.querySpec()
+		nodes       = []*Book{}
+		_spec       = bq.querySpec()
+		loadedTypes = [1]bool{
+			bq.withPolicies != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Book).scanValues(nil, columns)
@@ -340,6 +380,7 @@ func (bq *BookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Book, e
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Book{config: bq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -351,9 +392,47 @@ func (bq *BookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Book, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := bq.withPolicies; query != nil {
+		if err := bq.loadPolicies(ctx, query, nodes,
+			func(n *Book) { n.Edges.Policies = []*PricePolicy{} },
+			func(n *Book, e *PricePolicy) { n.Edges.Policies = append(n.Edges.Policies, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (bq *BookQuery) loadPolicies(ctx context.Context, query *PricePolicyQuery, nodes []*Book, init func(*Book), assign func(*Book, *PricePolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Book)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pricepolicy.FieldBookID)
+	}
+	query.Where(predicate.PricePolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(book.PoliciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BookID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "book_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (bq *BookQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bq.querySpec()
 	_spec.Node.Columns = bq.ctx.Fields
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/book"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/pricepolicy"
 )
 
 // BookUpdate is the builder for updating Book entities.
@@ -119,11 +120,47 @@ func (bu *BookUpdate) SetNillablePublicizedAt(t *time.Time) *BookUpdate {
 	return bu
 }
 
+// AddPolicyIDs adds the "policies" edge to the PricePolicy entity by IDs.
+func (bu *BookUpdate) AddPolicyIDs(ids ...int) *BookUpdate {
+	bu.mutation.AddPolicyIDs(ids...)
+	return bu
+}
+
+// AddPolicies adds the "policies" edges to the PricePolicy entity.
+func (bu *BookUpdate) AddPolicies(p ...*PricePolicy) *BookUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.AddPolicyIDs(ids...)
+}
+
 // Mutation returns the BookMutation object of the builder.
 func (bu *BookUpdate) Mutation() *BookMutation {
 	return bu.mutation
 }
 
+// ClearPolicies clears all "policies" edges to the PricePolicy entity.
+func (bu *BookUpdate) ClearPolicies() *BookUpdate {
+	bu.mutation.ClearPolicies()
+	return bu
+}
+
+// RemovePolicyIDs removes the "policies" edge to PricePolicy entities by IDs.
+func (bu *BookUpdate) RemovePolicyIDs(ids ...int) *BookUpdate {
+	bu.mutation.RemovePolicyIDs(ids...)
+	return bu
+}
+
+// RemovePolicies removes "policies" edges to PricePolicy entities.
+func (bu *BookUpdate) RemovePolicies(p ...*PricePolicy) *BookUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return bu.RemovePolicyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (bu *BookUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
@@ -181,6 +218,51 @@ func (bu *BookUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := bu.mutation.PublicizedAt(); ok {
 		_spec.SetField(book.FieldPublicizedAt, field.TypeTime, value)
 	}
+	if bu.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PoliciesTable,
+			Columns: []string{book.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedPoliciesIDs(); len(nodes) > 0 && !bu.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PoliciesTable,
+			Columns: []string{book.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.PoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PoliciesTable,
+			Columns: []string{book.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{book.Label}
@@ -292,11 +374,47 @@ func (buo *BookUpdateOne) SetNillablePublicizedAt(t *time.Time) *BookUpdateOne {
 	return buo
 }
 
+// AddPolicyIDs adds the "policies" edge to the PricePolicy entity by IDs.
+func (buo *BookUpdateOne) AddPolicyIDs(ids ...int) *BookUpdateOne {
+	buo.mutation.AddPolicyIDs(ids...)
+	return buo
+}
+
+// AddPolicies adds the "policies" edges to the PricePolicy entity.
+func (buo *BookUpdateOne) AddPolicies(p ...*PricePolicy) *BookUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.AddPolicyIDs(ids...)
+}
+
 // Mutation returns the BookMutation object of the builder.
 func (buo *BookUpdateOne) Mutation() *BookMutation {
 	return buo.mutation
 }
 
+// ClearPolicies clears all "policies" edges to the PricePolicy entity.
+func (buo *BookUpdateOne) ClearPolicies() *BookUpdateOne {
+	buo.mutation.ClearPolicies()
+	return buo
+}
+
+// RemovePolicyIDs removes the "policies" edge to PricePolicy entities by IDs.
+func (buo *BookUpdateOne) RemovePolicyIDs(ids ...int) *BookUpdateOne {
+	buo.mutation.RemovePolicyIDs(ids...)
+	return buo
+}
+
+// RemovePolicies removes "policies" edges to PricePolicy entities.
+func (buo *BookUpdateOne) RemovePolicies(p ...*PricePolicy) *BookUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return buo.RemovePolicyIDs(ids...)
+}
+
 // Where appends a list predicates to the BookUpdate builder.
 func (buo *BookUpdateOne) Where(ps ...predicate.Book) *BookUpdateOne {
 	buo.mutation.Where(ps...)
@@ -384,6 +502,51 @@ func (buo *BookUpdateOne) sqlSave(ctx context.Context) (_node *Book, err error)
 	if value, ok := buo.mutation.PublicizedAt(); ok {
 		_spec.SetField(book.FieldPublicizedAt, field.TypeTime, value)
 	}
+	if buo.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PoliciesTable,
+			Columns: []string{book.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedPoliciesIDs(); len(nodes) > 0 && !buo.mutation.PoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PoliciesTable,
+			Columns: []string{book.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.PoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.PoliciesTable,
+			Columns: []string{book.PoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Book{config: buo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -13,6 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/jsonbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/nullablebook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/pricepolicy"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/taggedbook"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +77,11 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			book.Table: book.ValidColumn,
+			book.Table:         book.ValidColumn,
+			jsonbook.Table:     jsonbook.ValidColumn,
+			nullablebook.Table: nullablebook.ValidColumn,
+			pricepolicy.Table:  pricepolicy.ValidColumn,
+			taggedbook.Table:   taggedbook.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
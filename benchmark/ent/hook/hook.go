@@ -21,6 +21,54 @@ func (f BookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BookMutation", m)
 }
 
+// The JSONBookFunc type is an adapter to allow the use of ordinary
+// function as JSONBook mutator.
+type JSONBookFunc func(context.Context, *ent.JSONBookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JSONBookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JSONBookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JSONBookMutation", m)
+}
+
+// The NullableBookFunc type is an adapter to allow the use of ordinary
+// function as NullableBook mutator.
+type NullableBookFunc func(context.Context, *ent.NullableBookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NullableBookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NullableBookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NullableBookMutation", m)
+}
+
+// The PricePolicyFunc type is an adapter to allow the use of ordinary
+// function as PricePolicy mutator.
+type PricePolicyFunc func(context.Context, *ent.PricePolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PricePolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PricePolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PricePolicyMutation", m)
+}
+
+// The TaggedBookFunc type is an adapter to allow the use of ordinary
+// function as TaggedBook mutator.
+type TaggedBookFunc func(context.Context, *ent.TaggedBookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaggedBookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaggedBookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaggedBookMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package jsonbook
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLTE(FieldID, id))
+}
+
+// Isbn applies equality check predicate on the "isbn" field. It's identical to IsbnEQ.
+func Isbn(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldIsbn, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldTitle, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldAuthor, v))
+}
+
+// Genre applies equality check predicate on the "genre" field. It's identical to GenreEQ.
+func Genre(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldGenre, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldQuantity, v))
+}
+
+// PublicizedAt applies equality check predicate on the "publicized_at" field. It's identical to PublicizedAtEQ.
+func PublicizedAt(v time.Time) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldPublicizedAt, v))
+}
+
+// IsbnEQ applies the EQ predicate on the "isbn" field.
+func IsbnEQ(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldIsbn, v))
+}
+
+// IsbnNEQ applies the NEQ predicate on the "isbn" field.
+func IsbnNEQ(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNEQ(FieldIsbn, v))
+}
+
+// IsbnIn applies the In predicate on the "isbn" field.
+func IsbnIn(vs ...string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldIn(FieldIsbn, vs...))
+}
+
+// IsbnNotIn applies the NotIn predicate on the "isbn" field.
+func IsbnNotIn(vs ...string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNotIn(FieldIsbn, vs...))
+}
+
+// IsbnGT applies the GT predicate on the "isbn" field.
+func IsbnGT(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGT(FieldIsbn, v))
+}
+
+// IsbnGTE applies the GTE predicate on the "isbn" field.
+func IsbnGTE(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGTE(FieldIsbn, v))
+}
+
+// IsbnLT applies the LT predicate on the "isbn" field.
+func IsbnLT(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLT(FieldIsbn, v))
+}
+
+// IsbnLTE applies the LTE predicate on the "isbn" field.
+func IsbnLTE(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLTE(FieldIsbn, v))
+}
+
+// IsbnContains applies the Contains predicate on the "isbn" field.
+func IsbnContains(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldContains(FieldIsbn, v))
+}
+
+// IsbnHasPrefix applies the HasPrefix predicate on the "isbn" field.
+func IsbnHasPrefix(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldHasPrefix(FieldIsbn, v))
+}
+
+// IsbnHasSuffix applies the HasSuffix predicate on the "isbn" field.
+func IsbnHasSuffix(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldHasSuffix(FieldIsbn, v))
+}
+
+// IsbnEqualFold applies the EqualFold predicate on the "isbn" field.
+func IsbnEqualFold(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEqualFold(FieldIsbn, v))
+}
+
+// IsbnContainsFold applies the ContainsFold predicate on the "isbn" field.
+func IsbnContainsFold(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldContainsFold(FieldIsbn, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// GenreEQ applies the EQ predicate on the "genre" field.
+func GenreEQ(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldGenre, v))
+}
+
+// GenreNEQ applies the NEQ predicate on the "genre" field.
+func GenreNEQ(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNEQ(FieldGenre, v))
+}
+
+// GenreIn applies the In predicate on the "genre" field.
+func GenreIn(vs ...string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldIn(FieldGenre, vs...))
+}
+
+// GenreNotIn applies the NotIn predicate on the "genre" field.
+func GenreNotIn(vs ...string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNotIn(FieldGenre, vs...))
+}
+
+// GenreGT applies the GT predicate on the "genre" field.
+func GenreGT(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGT(FieldGenre, v))
+}
+
+// GenreGTE applies the GTE predicate on the "genre" field.
+func GenreGTE(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGTE(FieldGenre, v))
+}
+
+// GenreLT applies the LT predicate on the "genre" field.
+func GenreLT(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLT(FieldGenre, v))
+}
+
+// GenreLTE applies the LTE predicate on the "genre" field.
+func GenreLTE(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLTE(FieldGenre, v))
+}
+
+// GenreContains applies the Contains predicate on the "genre" field.
+func GenreContains(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldContains(FieldGenre, v))
+}
+
+// GenreHasPrefix applies the HasPrefix predicate on the "genre" field.
+func GenreHasPrefix(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldHasPrefix(FieldGenre, v))
+}
+
+// GenreHasSuffix applies the HasSuffix predicate on the "genre" field.
+func GenreHasSuffix(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldHasSuffix(FieldGenre, v))
+}
+
+// GenreEqualFold applies the EqualFold predicate on the "genre" field.
+func GenreEqualFold(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEqualFold(FieldGenre, v))
+}
+
+// GenreContainsFold applies the ContainsFold predicate on the "genre" field.
+func GenreContainsFold(v string) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldContainsFold(FieldGenre, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLTE(FieldQuantity, v))
+}
+
+// PublicizedAtEQ applies the EQ predicate on the "publicized_at" field.
+func PublicizedAtEQ(v time.Time) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldEQ(FieldPublicizedAt, v))
+}
+
+// PublicizedAtNEQ applies the NEQ predicate on the "publicized_at" field.
+func PublicizedAtNEQ(v time.Time) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNEQ(FieldPublicizedAt, v))
+}
+
+// PublicizedAtIn applies the In predicate on the "publicized_at" field.
+func PublicizedAtIn(vs ...time.Time) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldIn(FieldPublicizedAt, vs...))
+}
+
+// PublicizedAtNotIn applies the NotIn predicate on the "publicized_at" field.
+func PublicizedAtNotIn(vs ...time.Time) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldNotIn(FieldPublicizedAt, vs...))
+}
+
+// PublicizedAtGT applies the GT predicate on the "publicized_at" field.
+func PublicizedAtGT(v time.Time) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGT(FieldPublicizedAt, v))
+}
+
+// PublicizedAtGTE applies the GTE predicate on the "publicized_at" field.
+func PublicizedAtGTE(v time.Time) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldGTE(FieldPublicizedAt, v))
+}
+
+// PublicizedAtLT applies the LT predicate on the "publicized_at" field.
+func PublicizedAtLT(v time.Time) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLT(FieldPublicizedAt, v))
+}
+
+// PublicizedAtLTE applies the LTE predicate on the "publicized_at" field.
+func PublicizedAtLTE(v time.Time) predicate.JSONBook {
+	return predicate.JSONBook(sql.FieldLTE(FieldPublicizedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.JSONBook) predicate.JSONBook {
+	return predicate.JSONBook(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.JSONBook) predicate.JSONBook {
+	return predicate.JSONBook(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.JSONBook) predicate.JSONBook {
+	return predicate.JSONBook(sql.NotPredicates(p))
+}
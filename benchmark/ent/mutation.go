@@ -12,7 +12,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/jsonbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/nullablebook"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/pricepolicy"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/taggedbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
 )
 
 const (
@@ -24,26 +29,33 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBook = "Book"
+	TypeBook         = "Book"
+	TypeJSONBook     = "JSONBook"
+	TypeNullableBook = "NullableBook"
+	TypePricePolicy  = "PricePolicy"
+	TypeTaggedBook   = "TaggedBook"
 )
 
 // BookMutation represents an operation that mutates the Book nodes in the graph.
 type BookMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	isbn          *string
-	title         *string
-	author        *string
-	genre         *string
-	quantity      *int
-	addquantity   *int
-	publicized_at *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Book, error)
-	predicates    []predicate.Book
+	op              Op
+	typ             string
+	id              *int
+	isbn            *string
+	title           *string
+	author          *string
+	genre           *string
+	quantity        *int
+	addquantity     *int
+	publicized_at   *time.Time
+	clearedFields   map[string]struct{}
+	policies        map[int]struct{}
+	removedpolicies map[int]struct{}
+	clearedpolicies bool
+	done            bool
+	oldValue        func(context.Context) (*Book, error)
+	predicates      []predicate.Book
 }
 
 var _ ent.Mutation = (*BookMutation)(nil)
@@ -380,6 +392,60 @@ func (m *BookMutation) ResetPublicizedAt() {
 	m.publicized_at = nil
 }
 
+// AddPolicyIDs adds the "policies" edge to the PricePolicy entity by ids.
+func (m *BookMutation) AddPolicyIDs(ids ...int) {
+	if m.policies == nil {
+		m.policies = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.policies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPolicies clears the "policies" edge to the PricePolicy entity.
+func (m *BookMutation) ClearPolicies() {
+	m.clearedpolicies = true
+}
+
+// PoliciesCleared reports if the "policies" edge to the PricePolicy entity was cleared.
+func (m *BookMutation) PoliciesCleared() bool {
+	return m.clearedpolicies
+}
+
+// RemovePolicyIDs removes the "policies" edge to the PricePolicy entity by IDs.
+func (m *BookMutation) RemovePolicyIDs(ids ...int) {
+	if m.removedpolicies == nil {
+		m.removedpolicies = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.policies, ids[i])
+		m.removedpolicies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPolicies returns the removed IDs of the "policies" edge to the PricePolicy entity.
+func (m *BookMutation) RemovedPoliciesIDs() (ids []int) {
+	for id := range m.removedpolicies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PoliciesIDs returns the "policies" edge IDs in the mutation.
+func (m *BookMutation) PoliciesIDs() (ids []int) {
+	for id := range m.policies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPolicies resets all changes to the "policies" edge.
+func (m *BookMutation) ResetPolicies() {
+	m.policies = nil
+	m.clearedpolicies = false
+	m.removedpolicies = nil
+}
+
 // Where appends a list predicates to the BookMutation builder.
 func (m *BookMutation) Where(ps ...predicate.Book) {
 	m.predicates = append(m.predicates, ps...)
@@ -613,48 +679,2910 @@ func (m *BookMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *BookMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.policies != nil {
+		edges = append(edges, book.EdgePolicies)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *BookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case book.EdgePolicies:
+		ids := make([]ent.Value, 0, len(m.policies))
+		for id := range m.policies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *BookMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.removedpolicies != nil {
+		edges = append(edges, book.EdgePolicies)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *BookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case book.EdgePolicies:
+		ids := make([]ent.Value, 0, len(m.removedpolicies))
+		for id := range m.removedpolicies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *BookMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.clearedpolicies {
+		edges = append(edges, book.EdgePolicies)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *BookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case book.EdgePolicies:
+		return m.clearedpolicies
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *BookMutation) ClearEdge(name string) error {
+	switch name {
+	}
 	return fmt.Errorf("unknown Book unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *BookMutation) ResetEdge(name string) error {
+	switch name {
+	case book.EdgePolicies:
+		m.ResetPolicies()
+		return nil
+	}
 	return fmt.Errorf("unknown Book edge %s", name)
 }
+
+// JSONBookMutation represents an operation that mutates the JSONBook nodes in the graph.
+type JSONBookMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	isbn          *string
+	title         *string
+	author        *string
+	genre         *string
+	quantity      *int
+	addquantity   *int
+	publicized_at *time.Time
+	metadata      *model.BookMetadata
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*JSONBook, error)
+	predicates    []predicate.JSONBook
+}
+
+var _ ent.Mutation = (*JSONBookMutation)(nil)
+
+// jsonbookOption allows management of the mutation configuration using functional options.
+type jsonbookOption func(*JSONBookMutation)
+
+// newJSONBookMutation creates new mutation for the JSONBook entity.
+func newJSONBookMutation(c config, op Op, opts ...jsonbookOption) *JSONBookMutation {
+	m := &JSONBookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJSONBook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJSONBookID sets the ID field of the mutation.
+func withJSONBookID(id int) jsonbookOption {
+	return func(m *JSONBookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *JSONBook
+		)
+		m.oldValue = func(ctx context.Context) (*JSONBook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().JSONBook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJSONBook sets the old JSONBook of the mutation.
+func withJSONBook(node *JSONBook) jsonbookOption {
+	return func(m *JSONBookMutation) {
+		m.oldValue = func(context.Context) (*JSONBook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JSONBookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JSONBookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JSONBookMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JSONBookMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().JSONBook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIsbn sets the "isbn" field.
+func (m *JSONBookMutation) SetIsbn(s string) {
+	m.isbn = &s
+}
+
+// Isbn returns the value of the "isbn" field in the mutation.
+func (m *JSONBookMutation) Isbn() (r string, exists bool) {
+	v := m.isbn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsbn returns the old "isbn" field's value of the JSONBook entity.
+// If the JSONBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONBookMutation) OldIsbn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsbn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsbn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsbn: %w", err)
+	}
+	return oldValue.Isbn, nil
+}
+
+// ResetIsbn resets all changes to the "isbn" field.
+func (m *JSONBookMutation) ResetIsbn() {
+	m.isbn = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *JSONBookMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *JSONBookMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the JSONBook entity.
+// If the JSONBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONBookMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *JSONBookMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *JSONBookMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *JSONBookMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the JSONBook entity.
+// If the JSONBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONBookMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *JSONBookMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetGenre sets the "genre" field.
+func (m *JSONBookMutation) SetGenre(s string) {
+	m.genre = &s
+}
+
+// Genre returns the value of the "genre" field in the mutation.
+func (m *JSONBookMutation) Genre() (r string, exists bool) {
+	v := m.genre
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenre returns the old "genre" field's value of the JSONBook entity.
+// If the JSONBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONBookMutation) OldGenre(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenre requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
+	}
+	return oldValue.Genre, nil
+}
+
+// ResetGenre resets all changes to the "genre" field.
+func (m *JSONBookMutation) ResetGenre() {
+	m.genre = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *JSONBookMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *JSONBookMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the JSONBook entity.
+// If the JSONBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONBookMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *JSONBookMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *JSONBookMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *JSONBookMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (m *JSONBookMutation) SetPublicizedAt(t time.Time) {
+	m.publicized_at = &t
+}
+
+// PublicizedAt returns the value of the "publicized_at" field in the mutation.
+func (m *JSONBookMutation) PublicizedAt() (r time.Time, exists bool) {
+	v := m.publicized_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicizedAt returns the old "publicized_at" field's value of the JSONBook entity.
+// If the JSONBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONBookMutation) OldPublicizedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicizedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicizedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicizedAt: %w", err)
+	}
+	return oldValue.PublicizedAt, nil
+}
+
+// ResetPublicizedAt resets all changes to the "publicized_at" field.
+func (m *JSONBookMutation) ResetPublicizedAt() {
+	m.publicized_at = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *JSONBookMutation) SetMetadata(mm model.BookMetadata) {
+	m.metadata = &mm
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *JSONBookMutation) Metadata() (r model.BookMetadata, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the JSONBook entity.
+// If the JSONBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JSONBookMutation) OldMetadata(ctx context.Context) (v model.BookMetadata, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *JSONBookMutation) ResetMetadata() {
+	m.metadata = nil
+}
+
+// Where appends a list predicates to the JSONBookMutation builder.
+func (m *JSONBookMutation) Where(ps ...predicate.JSONBook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JSONBookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JSONBookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JSONBook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JSONBookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JSONBookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (JSONBook).
+func (m *JSONBookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JSONBookMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.isbn != nil {
+		fields = append(fields, jsonbook.FieldIsbn)
+	}
+	if m.title != nil {
+		fields = append(fields, jsonbook.FieldTitle)
+	}
+	if m.author != nil {
+		fields = append(fields, jsonbook.FieldAuthor)
+	}
+	if m.genre != nil {
+		fields = append(fields, jsonbook.FieldGenre)
+	}
+	if m.quantity != nil {
+		fields = append(fields, jsonbook.FieldQuantity)
+	}
+	if m.publicized_at != nil {
+		fields = append(fields, jsonbook.FieldPublicizedAt)
+	}
+	if m.metadata != nil {
+		fields = append(fields, jsonbook.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JSONBookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case jsonbook.FieldIsbn:
+		return m.Isbn()
+	case jsonbook.FieldTitle:
+		return m.Title()
+	case jsonbook.FieldAuthor:
+		return m.Author()
+	case jsonbook.FieldGenre:
+		return m.Genre()
+	case jsonbook.FieldQuantity:
+		return m.Quantity()
+	case jsonbook.FieldPublicizedAt:
+		return m.PublicizedAt()
+	case jsonbook.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JSONBookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case jsonbook.FieldIsbn:
+		return m.OldIsbn(ctx)
+	case jsonbook.FieldTitle:
+		return m.OldTitle(ctx)
+	case jsonbook.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case jsonbook.FieldGenre:
+		return m.OldGenre(ctx)
+	case jsonbook.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case jsonbook.FieldPublicizedAt:
+		return m.OldPublicizedAt(ctx)
+	case jsonbook.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown JSONBook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JSONBookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case jsonbook.FieldIsbn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsbn(v)
+		return nil
+	case jsonbook.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case jsonbook.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case jsonbook.FieldGenre:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenre(v)
+		return nil
+	case jsonbook.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case jsonbook.FieldPublicizedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicizedAt(v)
+		return nil
+	case jsonbook.FieldMetadata:
+		v, ok := value.(model.BookMetadata)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JSONBook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JSONBookMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, jsonbook.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JSONBookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case jsonbook.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JSONBookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case jsonbook.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JSONBook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JSONBookMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JSONBookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JSONBookMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown JSONBook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JSONBookMutation) ResetField(name string) error {
+	switch name {
+	case jsonbook.FieldIsbn:
+		m.ResetIsbn()
+		return nil
+	case jsonbook.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case jsonbook.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case jsonbook.FieldGenre:
+		m.ResetGenre()
+		return nil
+	case jsonbook.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case jsonbook.FieldPublicizedAt:
+		m.ResetPublicizedAt()
+		return nil
+	case jsonbook.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown JSONBook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JSONBookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JSONBookMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JSONBookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JSONBookMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JSONBookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JSONBookMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JSONBookMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown JSONBook unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JSONBookMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown JSONBook edge %s", name)
+}
+
+// NullableBookMutation represents an operation that mutates the NullableBook nodes in the graph.
+type NullableBookMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	isbn            *string
+	title           *string
+	subtitle        *string
+	description     *string
+	author          *string
+	genre           *string
+	quantity        *int
+	addquantity     *int
+	publicized_at   *time.Time
+	discontinued_at *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*NullableBook, error)
+	predicates      []predicate.NullableBook
+}
+
+var _ ent.Mutation = (*NullableBookMutation)(nil)
+
+// nullablebookOption allows management of the mutation configuration using functional options.
+type nullablebookOption func(*NullableBookMutation)
+
+// newNullableBookMutation creates new mutation for the NullableBook entity.
+func newNullableBookMutation(c config, op Op, opts ...nullablebookOption) *NullableBookMutation {
+	m := &NullableBookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNullableBook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNullableBookID sets the ID field of the mutation.
+func withNullableBookID(id int) nullablebookOption {
+	return func(m *NullableBookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NullableBook
+		)
+		m.oldValue = func(ctx context.Context) (*NullableBook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NullableBook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNullableBook sets the old NullableBook of the mutation.
+func withNullableBook(node *NullableBook) nullablebookOption {
+	return func(m *NullableBookMutation) {
+		m.oldValue = func(context.Context) (*NullableBook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NullableBookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NullableBookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NullableBookMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NullableBookMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NullableBook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIsbn sets the "isbn" field.
+func (m *NullableBookMutation) SetIsbn(s string) {
+	m.isbn = &s
+}
+
+// Isbn returns the value of the "isbn" field in the mutation.
+func (m *NullableBookMutation) Isbn() (r string, exists bool) {
+	v := m.isbn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsbn returns the old "isbn" field's value of the NullableBook entity.
+// If the NullableBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NullableBookMutation) OldIsbn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsbn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsbn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsbn: %w", err)
+	}
+	return oldValue.Isbn, nil
+}
+
+// ResetIsbn resets all changes to the "isbn" field.
+func (m *NullableBookMutation) ResetIsbn() {
+	m.isbn = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *NullableBookMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *NullableBookMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the NullableBook entity.
+// If the NullableBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NullableBookMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *NullableBookMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (m *NullableBookMutation) SetSubtitle(s string) {
+	m.subtitle = &s
+}
+
+// Subtitle returns the value of the "subtitle" field in the mutation.
+func (m *NullableBookMutation) Subtitle() (r string, exists bool) {
+	v := m.subtitle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtitle returns the old "subtitle" field's value of the NullableBook entity.
+// If the NullableBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NullableBookMutation) OldSubtitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtitle: %w", err)
+	}
+	return oldValue.Subtitle, nil
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (m *NullableBookMutation) ClearSubtitle() {
+	m.subtitle = nil
+	m.clearedFields[nullablebook.FieldSubtitle] = struct{}{}
+}
+
+// SubtitleCleared returns if the "subtitle" field was cleared in this mutation.
+func (m *NullableBookMutation) SubtitleCleared() bool {
+	_, ok := m.clearedFields[nullablebook.FieldSubtitle]
+	return ok
+}
+
+// ResetSubtitle resets all changes to the "subtitle" field.
+func (m *NullableBookMutation) ResetSubtitle() {
+	m.subtitle = nil
+	delete(m.clearedFields, nullablebook.FieldSubtitle)
+}
+
+// SetDescription sets the "description" field.
+func (m *NullableBookMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *NullableBookMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the NullableBook entity.
+// If the NullableBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NullableBookMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *NullableBookMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[nullablebook.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *NullableBookMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[nullablebook.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *NullableBookMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, nullablebook.FieldDescription)
+}
+
+// SetAuthor sets the "author" field.
+func (m *NullableBookMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *NullableBookMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the NullableBook entity.
+// If the NullableBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NullableBookMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *NullableBookMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetGenre sets the "genre" field.
+func (m *NullableBookMutation) SetGenre(s string) {
+	m.genre = &s
+}
+
+// Genre returns the value of the "genre" field in the mutation.
+func (m *NullableBookMutation) Genre() (r string, exists bool) {
+	v := m.genre
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenre returns the old "genre" field's value of the NullableBook entity.
+// If the NullableBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NullableBookMutation) OldGenre(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenre requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
+	}
+	return oldValue.Genre, nil
+}
+
+// ResetGenre resets all changes to the "genre" field.
+func (m *NullableBookMutation) ResetGenre() {
+	m.genre = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *NullableBookMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *NullableBookMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the NullableBook entity.
+// If the NullableBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NullableBookMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *NullableBookMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *NullableBookMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *NullableBookMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (m *NullableBookMutation) SetPublicizedAt(t time.Time) {
+	m.publicized_at = &t
+}
+
+// PublicizedAt returns the value of the "publicized_at" field in the mutation.
+func (m *NullableBookMutation) PublicizedAt() (r time.Time, exists bool) {
+	v := m.publicized_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicizedAt returns the old "publicized_at" field's value of the NullableBook entity.
+// If the NullableBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NullableBookMutation) OldPublicizedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicizedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicizedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicizedAt: %w", err)
+	}
+	return oldValue.PublicizedAt, nil
+}
+
+// ResetPublicizedAt resets all changes to the "publicized_at" field.
+func (m *NullableBookMutation) ResetPublicizedAt() {
+	m.publicized_at = nil
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (m *NullableBookMutation) SetDiscontinuedAt(t time.Time) {
+	m.discontinued_at = &t
+}
+
+// DiscontinuedAt returns the value of the "discontinued_at" field in the mutation.
+func (m *NullableBookMutation) DiscontinuedAt() (r time.Time, exists bool) {
+	v := m.discontinued_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscontinuedAt returns the old "discontinued_at" field's value of the NullableBook entity.
+// If the NullableBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NullableBookMutation) OldDiscontinuedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscontinuedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscontinuedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscontinuedAt: %w", err)
+	}
+	return oldValue.DiscontinuedAt, nil
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (m *NullableBookMutation) ClearDiscontinuedAt() {
+	m.discontinued_at = nil
+	m.clearedFields[nullablebook.FieldDiscontinuedAt] = struct{}{}
+}
+
+// DiscontinuedAtCleared returns if the "discontinued_at" field was cleared in this mutation.
+func (m *NullableBookMutation) DiscontinuedAtCleared() bool {
+	_, ok := m.clearedFields[nullablebook.FieldDiscontinuedAt]
+	return ok
+}
+
+// ResetDiscontinuedAt resets all changes to the "discontinued_at" field.
+func (m *NullableBookMutation) ResetDiscontinuedAt() {
+	m.discontinued_at = nil
+	delete(m.clearedFields, nullablebook.FieldDiscontinuedAt)
+}
+
+// Where appends a list predicates to the NullableBookMutation builder.
+func (m *NullableBookMutation) Where(ps ...predicate.NullableBook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NullableBookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NullableBookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NullableBook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NullableBookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NullableBookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NullableBook).
+func (m *NullableBookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NullableBookMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.isbn != nil {
+		fields = append(fields, nullablebook.FieldIsbn)
+	}
+	if m.title != nil {
+		fields = append(fields, nullablebook.FieldTitle)
+	}
+	if m.subtitle != nil {
+		fields = append(fields, nullablebook.FieldSubtitle)
+	}
+	if m.description != nil {
+		fields = append(fields, nullablebook.FieldDescription)
+	}
+	if m.author != nil {
+		fields = append(fields, nullablebook.FieldAuthor)
+	}
+	if m.genre != nil {
+		fields = append(fields, nullablebook.FieldGenre)
+	}
+	if m.quantity != nil {
+		fields = append(fields, nullablebook.FieldQuantity)
+	}
+	if m.publicized_at != nil {
+		fields = append(fields, nullablebook.FieldPublicizedAt)
+	}
+	if m.discontinued_at != nil {
+		fields = append(fields, nullablebook.FieldDiscontinuedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NullableBookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case nullablebook.FieldIsbn:
+		return m.Isbn()
+	case nullablebook.FieldTitle:
+		return m.Title()
+	case nullablebook.FieldSubtitle:
+		return m.Subtitle()
+	case nullablebook.FieldDescription:
+		return m.Description()
+	case nullablebook.FieldAuthor:
+		return m.Author()
+	case nullablebook.FieldGenre:
+		return m.Genre()
+	case nullablebook.FieldQuantity:
+		return m.Quantity()
+	case nullablebook.FieldPublicizedAt:
+		return m.PublicizedAt()
+	case nullablebook.FieldDiscontinuedAt:
+		return m.DiscontinuedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NullableBookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case nullablebook.FieldIsbn:
+		return m.OldIsbn(ctx)
+	case nullablebook.FieldTitle:
+		return m.OldTitle(ctx)
+	case nullablebook.FieldSubtitle:
+		return m.OldSubtitle(ctx)
+	case nullablebook.FieldDescription:
+		return m.OldDescription(ctx)
+	case nullablebook.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case nullablebook.FieldGenre:
+		return m.OldGenre(ctx)
+	case nullablebook.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case nullablebook.FieldPublicizedAt:
+		return m.OldPublicizedAt(ctx)
+	case nullablebook.FieldDiscontinuedAt:
+		return m.OldDiscontinuedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown NullableBook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NullableBookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case nullablebook.FieldIsbn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsbn(v)
+		return nil
+	case nullablebook.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case nullablebook.FieldSubtitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtitle(v)
+		return nil
+	case nullablebook.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case nullablebook.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case nullablebook.FieldGenre:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenre(v)
+		return nil
+	case nullablebook.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case nullablebook.FieldPublicizedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicizedAt(v)
+		return nil
+	case nullablebook.FieldDiscontinuedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscontinuedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NullableBook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NullableBookMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, nullablebook.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NullableBookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case nullablebook.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NullableBookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case nullablebook.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NullableBook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NullableBookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(nullablebook.FieldSubtitle) {
+		fields = append(fields, nullablebook.FieldSubtitle)
+	}
+	if m.FieldCleared(nullablebook.FieldDescription) {
+		fields = append(fields, nullablebook.FieldDescription)
+	}
+	if m.FieldCleared(nullablebook.FieldDiscontinuedAt) {
+		fields = append(fields, nullablebook.FieldDiscontinuedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NullableBookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NullableBookMutation) ClearField(name string) error {
+	switch name {
+	case nullablebook.FieldSubtitle:
+		m.ClearSubtitle()
+		return nil
+	case nullablebook.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case nullablebook.FieldDiscontinuedAt:
+		m.ClearDiscontinuedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NullableBook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NullableBookMutation) ResetField(name string) error {
+	switch name {
+	case nullablebook.FieldIsbn:
+		m.ResetIsbn()
+		return nil
+	case nullablebook.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case nullablebook.FieldSubtitle:
+		m.ResetSubtitle()
+		return nil
+	case nullablebook.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case nullablebook.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case nullablebook.FieldGenre:
+		m.ResetGenre()
+		return nil
+	case nullablebook.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case nullablebook.FieldPublicizedAt:
+		m.ResetPublicizedAt()
+		return nil
+	case nullablebook.FieldDiscontinuedAt:
+		m.ResetDiscontinuedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown NullableBook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NullableBookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NullableBookMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NullableBookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NullableBookMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NullableBookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NullableBookMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NullableBookMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NullableBook unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NullableBookMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NullableBook edge %s", name)
+}
+
+// PricePolicyMutation represents an operation that mutates the PricePolicy nodes in the graph.
+type PricePolicyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	price         *float64
+	addprice      *float64
+	start_date    *time.Time
+	end_date      *time.Time
+	clearedFields map[string]struct{}
+	book          *int
+	clearedbook   bool
+	done          bool
+	oldValue      func(context.Context) (*PricePolicy, error)
+	predicates    []predicate.PricePolicy
+}
+
+var _ ent.Mutation = (*PricePolicyMutation)(nil)
+
+// pricepolicyOption allows management of the mutation configuration using functional options.
+type pricepolicyOption func(*PricePolicyMutation)
+
+// newPricePolicyMutation creates new mutation for the PricePolicy entity.
+func newPricePolicyMutation(c config, op Op, opts ...pricepolicyOption) *PricePolicyMutation {
+	m := &PricePolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePricePolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPricePolicyID sets the ID field of the mutation.
+func withPricePolicyID(id int) pricepolicyOption {
+	return func(m *PricePolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PricePolicy
+		)
+		m.oldValue = func(ctx context.Context) (*PricePolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PricePolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPricePolicy sets the old PricePolicy of the mutation.
+func withPricePolicy(node *PricePolicy) pricepolicyOption {
+	return func(m *PricePolicyMutation) {
+		m.oldValue = func(context.Context) (*PricePolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PricePolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PricePolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PricePolicyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PricePolicyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PricePolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBookID sets the "book_id" field.
+func (m *PricePolicyMutation) SetBookID(i int) {
+	m.book = &i
+}
+
+// BookID returns the value of the "book_id" field in the mutation.
+func (m *PricePolicyMutation) BookID() (r int, exists bool) {
+	v := m.book
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBookID returns the old "book_id" field's value of the PricePolicy entity.
+// If the PricePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PricePolicyMutation) OldBookID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBookID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBookID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBookID: %w", err)
+	}
+	return oldValue.BookID, nil
+}
+
+// ClearBookID clears the value of the "book_id" field.
+func (m *PricePolicyMutation) ClearBookID() {
+	m.book = nil
+	m.clearedFields[pricepolicy.FieldBookID] = struct{}{}
+}
+
+// BookIDCleared returns if the "book_id" field was cleared in this mutation.
+func (m *PricePolicyMutation) BookIDCleared() bool {
+	_, ok := m.clearedFields[pricepolicy.FieldBookID]
+	return ok
+}
+
+// ResetBookID resets all changes to the "book_id" field.
+func (m *PricePolicyMutation) ResetBookID() {
+	m.book = nil
+	delete(m.clearedFields, pricepolicy.FieldBookID)
+}
+
+// SetPrice sets the "price" field.
+func (m *PricePolicyMutation) SetPrice(f float64) {
+	m.price = &f
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *PricePolicyMutation) Price() (r float64, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the PricePolicy entity.
+// If the PricePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PricePolicyMutation) OldPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds f to the "price" field.
+func (m *PricePolicyMutation) AddPrice(f float64) {
+	if m.addprice != nil {
+		*m.addprice += f
+	} else {
+		m.addprice = &f
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *PricePolicyMutation) AddedPrice() (r float64, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *PricePolicyMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetStartDate sets the "start_date" field.
+func (m *PricePolicyMutation) SetStartDate(t time.Time) {
+	m.start_date = &t
+}
+
+// StartDate returns the value of the "start_date" field in the mutation.
+func (m *PricePolicyMutation) StartDate() (r time.Time, exists bool) {
+	v := m.start_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartDate returns the old "start_date" field's value of the PricePolicy entity.
+// If the PricePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PricePolicyMutation) OldStartDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartDate: %w", err)
+	}
+	return oldValue.StartDate, nil
+}
+
+// ResetStartDate resets all changes to the "start_date" field.
+func (m *PricePolicyMutation) ResetStartDate() {
+	m.start_date = nil
+}
+
+// SetEndDate sets the "end_date" field.
+func (m *PricePolicyMutation) SetEndDate(t time.Time) {
+	m.end_date = &t
+}
+
+// EndDate returns the value of the "end_date" field in the mutation.
+func (m *PricePolicyMutation) EndDate() (r time.Time, exists bool) {
+	v := m.end_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndDate returns the old "end_date" field's value of the PricePolicy entity.
+// If the PricePolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PricePolicyMutation) OldEndDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndDate: %w", err)
+	}
+	return oldValue.EndDate, nil
+}
+
+// ResetEndDate resets all changes to the "end_date" field.
+func (m *PricePolicyMutation) ResetEndDate() {
+	m.end_date = nil
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *PricePolicyMutation) ClearBook() {
+	m.clearedbook = true
+	m.clearedFields[pricepolicy.FieldBookID] = struct{}{}
+}
+
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *PricePolicyMutation) BookCleared() bool {
+	return m.BookIDCleared() || m.clearedbook
+}
+
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *PricePolicyMutation) BookIDs() (ids []int) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBook resets all changes to the "book" edge.
+func (m *PricePolicyMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
+}
+
+// Where appends a list predicates to the PricePolicyMutation builder.
+func (m *PricePolicyMutation) Where(ps ...predicate.PricePolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PricePolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PricePolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PricePolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PricePolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PricePolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PricePolicy).
+func (m *PricePolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PricePolicyMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.book != nil {
+		fields = append(fields, pricepolicy.FieldBookID)
+	}
+	if m.price != nil {
+		fields = append(fields, pricepolicy.FieldPrice)
+	}
+	if m.start_date != nil {
+		fields = append(fields, pricepolicy.FieldStartDate)
+	}
+	if m.end_date != nil {
+		fields = append(fields, pricepolicy.FieldEndDate)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PricePolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pricepolicy.FieldBookID:
+		return m.BookID()
+	case pricepolicy.FieldPrice:
+		return m.Price()
+	case pricepolicy.FieldStartDate:
+		return m.StartDate()
+	case pricepolicy.FieldEndDate:
+		return m.EndDate()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PricePolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pricepolicy.FieldBookID:
+		return m.OldBookID(ctx)
+	case pricepolicy.FieldPrice:
+		return m.OldPrice(ctx)
+	case pricepolicy.FieldStartDate:
+		return m.OldStartDate(ctx)
+	case pricepolicy.FieldEndDate:
+		return m.OldEndDate(ctx)
+	}
+	return nil, fmt.Errorf("unknown PricePolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PricePolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pricepolicy.FieldBookID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookID(v)
+		return nil
+	case pricepolicy.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case pricepolicy.FieldStartDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartDate(v)
+		return nil
+	case pricepolicy.FieldEndDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndDate(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PricePolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addprice != nil {
+		fields = append(fields, pricepolicy.FieldPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PricePolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pricepolicy.FieldPrice:
+		return m.AddedPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PricePolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pricepolicy.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PricePolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pricepolicy.FieldBookID) {
+		fields = append(fields, pricepolicy.FieldBookID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PricePolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PricePolicyMutation) ClearField(name string) error {
+	switch name {
+	case pricepolicy.FieldBookID:
+		m.ClearBookID()
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PricePolicyMutation) ResetField(name string) error {
+	switch name {
+	case pricepolicy.FieldBookID:
+		m.ResetBookID()
+		return nil
+	case pricepolicy.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case pricepolicy.FieldStartDate:
+		m.ResetStartDate()
+		return nil
+	case pricepolicy.FieldEndDate:
+		m.ResetEndDate()
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PricePolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.book != nil {
+		edges = append(edges, pricepolicy.EdgeBook)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PricePolicyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pricepolicy.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PricePolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PricePolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PricePolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbook {
+		edges = append(edges, pricepolicy.EdgeBook)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PricePolicyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pricepolicy.EdgeBook:
+		return m.clearedbook
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PricePolicyMutation) ClearEdge(name string) error {
+	switch name {
+	case pricepolicy.EdgeBook:
+		m.ClearBook()
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PricePolicyMutation) ResetEdge(name string) error {
+	switch name {
+	case pricepolicy.EdgeBook:
+		m.ResetBook()
+		return nil
+	}
+	return fmt.Errorf("unknown PricePolicy edge %s", name)
+}
+
+// TaggedBookMutation represents an operation that mutates the TaggedBook nodes in the graph.
+type TaggedBookMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	isbn          *string
+	title         *string
+	author        *string
+	genre         *string
+	quantity      *int
+	addquantity   *int
+	publicized_at *time.Time
+	tags          *model.TextArray
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TaggedBook, error)
+	predicates    []predicate.TaggedBook
+}
+
+var _ ent.Mutation = (*TaggedBookMutation)(nil)
+
+// taggedbookOption allows management of the mutation configuration using functional options.
+type taggedbookOption func(*TaggedBookMutation)
+
+// newTaggedBookMutation creates new mutation for the TaggedBook entity.
+func newTaggedBookMutation(c config, op Op, opts ...taggedbookOption) *TaggedBookMutation {
+	m := &TaggedBookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTaggedBook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaggedBookID sets the ID field of the mutation.
+func withTaggedBookID(id int) taggedbookOption {
+	return func(m *TaggedBookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TaggedBook
+		)
+		m.oldValue = func(ctx context.Context) (*TaggedBook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TaggedBook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTaggedBook sets the old TaggedBook of the mutation.
+func withTaggedBook(node *TaggedBook) taggedbookOption {
+	return func(m *TaggedBookMutation) {
+		m.oldValue = func(context.Context) (*TaggedBook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaggedBookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaggedBookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaggedBookMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaggedBookMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaggedBook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIsbn sets the "isbn" field.
+func (m *TaggedBookMutation) SetIsbn(s string) {
+	m.isbn = &s
+}
+
+// Isbn returns the value of the "isbn" field in the mutation.
+func (m *TaggedBookMutation) Isbn() (r string, exists bool) {
+	v := m.isbn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsbn returns the old "isbn" field's value of the TaggedBook entity.
+// If the TaggedBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaggedBookMutation) OldIsbn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsbn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsbn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsbn: %w", err)
+	}
+	return oldValue.Isbn, nil
+}
+
+// ResetIsbn resets all changes to the "isbn" field.
+func (m *TaggedBookMutation) ResetIsbn() {
+	m.isbn = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *TaggedBookMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *TaggedBookMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the TaggedBook entity.
+// If the TaggedBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaggedBookMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *TaggedBookMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *TaggedBookMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *TaggedBookMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the TaggedBook entity.
+// If the TaggedBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaggedBookMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *TaggedBookMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetGenre sets the "genre" field.
+func (m *TaggedBookMutation) SetGenre(s string) {
+	m.genre = &s
+}
+
+// Genre returns the value of the "genre" field in the mutation.
+func (m *TaggedBookMutation) Genre() (r string, exists bool) {
+	v := m.genre
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGenre returns the old "genre" field's value of the TaggedBook entity.
+// If the TaggedBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaggedBookMutation) OldGenre(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGenre is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGenre requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGenre: %w", err)
+	}
+	return oldValue.Genre, nil
+}
+
+// ResetGenre resets all changes to the "genre" field.
+func (m *TaggedBookMutation) ResetGenre() {
+	m.genre = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *TaggedBookMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *TaggedBookMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the TaggedBook entity.
+// If the TaggedBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaggedBookMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *TaggedBookMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *TaggedBookMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *TaggedBookMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (m *TaggedBookMutation) SetPublicizedAt(t time.Time) {
+	m.publicized_at = &t
+}
+
+// PublicizedAt returns the value of the "publicized_at" field in the mutation.
+func (m *TaggedBookMutation) PublicizedAt() (r time.Time, exists bool) {
+	v := m.publicized_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicizedAt returns the old "publicized_at" field's value of the TaggedBook entity.
+// If the TaggedBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaggedBookMutation) OldPublicizedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicizedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicizedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicizedAt: %w", err)
+	}
+	return oldValue.PublicizedAt, nil
+}
+
+// ResetPublicizedAt resets all changes to the "publicized_at" field.
+func (m *TaggedBookMutation) ResetPublicizedAt() {
+	m.publicized_at = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *TaggedBookMutation) SetTags(ma model.TextArray) {
+	m.tags = &ma
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *TaggedBookMutation) Tags() (r model.TextArray, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the TaggedBook entity.
+// If the TaggedBook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaggedBookMutation) OldTags(ctx context.Context) (v model.TextArray, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *TaggedBookMutation) ResetTags() {
+	m.tags = nil
+}
+
+// Where appends a list predicates to the TaggedBookMutation builder.
+func (m *TaggedBookMutation) Where(ps ...predicate.TaggedBook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaggedBookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaggedBookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaggedBook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaggedBookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaggedBookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaggedBook).
+func (m *TaggedBookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaggedBookMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.isbn != nil {
+		fields = append(fields, taggedbook.FieldIsbn)
+	}
+	if m.title != nil {
+		fields = append(fields, taggedbook.FieldTitle)
+	}
+	if m.author != nil {
+		fields = append(fields, taggedbook.FieldAuthor)
+	}
+	if m.genre != nil {
+		fields = append(fields, taggedbook.FieldGenre)
+	}
+	if m.quantity != nil {
+		fields = append(fields, taggedbook.FieldQuantity)
+	}
+	if m.publicized_at != nil {
+		fields = append(fields, taggedbook.FieldPublicizedAt)
+	}
+	if m.tags != nil {
+		fields = append(fields, taggedbook.FieldTags)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaggedBookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taggedbook.FieldIsbn:
+		return m.Isbn()
+	case taggedbook.FieldTitle:
+		return m.Title()
+	case taggedbook.FieldAuthor:
+		return m.Author()
+	case taggedbook.FieldGenre:
+		return m.Genre()
+	case taggedbook.FieldQuantity:
+		return m.Quantity()
+	case taggedbook.FieldPublicizedAt:
+		return m.PublicizedAt()
+	case taggedbook.FieldTags:
+		return m.Tags()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaggedBookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case taggedbook.FieldIsbn:
+		return m.OldIsbn(ctx)
+	case taggedbook.FieldTitle:
+		return m.OldTitle(ctx)
+	case taggedbook.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case taggedbook.FieldGenre:
+		return m.OldGenre(ctx)
+	case taggedbook.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case taggedbook.FieldPublicizedAt:
+		return m.OldPublicizedAt(ctx)
+	case taggedbook.FieldTags:
+		return m.OldTags(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaggedBook field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaggedBookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taggedbook.FieldIsbn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsbn(v)
+		return nil
+	case taggedbook.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case taggedbook.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case taggedbook.FieldGenre:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGenre(v)
+		return nil
+	case taggedbook.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case taggedbook.FieldPublicizedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicizedAt(v)
+		return nil
+	case taggedbook.FieldTags:
+		v, ok := value.(model.TextArray)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaggedBook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaggedBookMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, taggedbook.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaggedBookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case taggedbook.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaggedBookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case taggedbook.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaggedBook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaggedBookMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaggedBookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaggedBookMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TaggedBook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaggedBookMutation) ResetField(name string) error {
+	switch name {
+	case taggedbook.FieldIsbn:
+		m.ResetIsbn()
+		return nil
+	case taggedbook.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case taggedbook.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case taggedbook.FieldGenre:
+		m.ResetGenre()
+		return nil
+	case taggedbook.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case taggedbook.FieldPublicizedAt:
+		m.ResetPublicizedAt()
+		return nil
+	case taggedbook.FieldTags:
+		m.ResetTags()
+		return nil
+	}
+	return fmt.Errorf("unknown TaggedBook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaggedBookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaggedBookMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaggedBookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaggedBookMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaggedBookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaggedBookMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaggedBookMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TaggedBook unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaggedBookMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TaggedBook edge %s", name)
+}
@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/nullablebook"
+)
+
+// NullableBook is the model entity for the NullableBook schema.
+type NullableBook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Isbn holds the value of the "isbn" field.
+	Isbn string `json:"isbn,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Subtitle holds the value of the "subtitle" field.
+	Subtitle *string `json:"subtitle,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// Genre holds the value of the "genre" field.
+	Genre string `json:"genre,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// PublicizedAt holds the value of the "publicized_at" field.
+	PublicizedAt time.Time `json:"publicized_at,omitempty"`
+	// DiscontinuedAt holds the value of the "discontinued_at" field.
+	DiscontinuedAt *time.Time `json:"discontinued_at,omitempty"`
+	selectValues   sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NullableBook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case nullablebook.FieldID, nullablebook.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case nullablebook.FieldIsbn, nullablebook.FieldTitle, nullablebook.FieldSubtitle, nullablebook.FieldDescription, nullablebook.FieldAuthor, nullablebook.FieldGenre:
+			values[i] = new(sql.NullString)
+		case nullablebook.FieldPublicizedAt, nullablebook.FieldDiscontinuedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NullableBook fields.
+func (nb *NullableBook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case nullablebook.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			nb.ID = int(value.Int64)
+		case nullablebook.FieldIsbn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field isbn", values[i])
+			} else if value.Valid {
+				nb.Isbn = value.String
+			}
+		case nullablebook.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				nb.Title = value.String
+			}
+		case nullablebook.FieldSubtitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subtitle", values[i])
+			} else if value.Valid {
+				nb.Subtitle = new(string)
+				*nb.Subtitle = value.String
+			}
+		case nullablebook.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				nb.Description = new(string)
+				*nb.Description = value.String
+			}
+		case nullablebook.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				nb.Author = value.String
+			}
+		case nullablebook.FieldGenre:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field genre", values[i])
+			} else if value.Valid {
+				nb.Genre = value.String
+			}
+		case nullablebook.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				nb.Quantity = int(value.Int64)
+			}
+		case nullablebook.FieldPublicizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field publicized_at", values[i])
+			} else if value.Valid {
+				nb.PublicizedAt = value.Time
+			}
+		case nullablebook.FieldDiscontinuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field discontinued_at", values[i])
+			} else if value.Valid {
+				nb.DiscontinuedAt = new(time.Time)
+				*nb.DiscontinuedAt = value.Time
+			}
+		default:
+			nb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NullableBook.
+// This includes values selected through modifiers, order, etc.
+func (nb *NullableBook) Value(name string) (ent.Value, error) {
+	return nb.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NullableBook.
+// Note that you need to call NullableBook.Unwrap() before calling this method if this NullableBook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nb *NullableBook) Update() *NullableBookUpdateOne {
+	return NewNullableBookClient(nb.config).UpdateOne(nb)
+}
+
+// Unwrap unwraps the NullableBook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nb *NullableBook) Unwrap() *NullableBook {
+	_tx, ok := nb.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NullableBook is not a transactional entity")
+	}
+	nb.config.driver = _tx.drv
+	return nb
+}
+
+// String implements the fmt.Stringer.
+func (nb *NullableBook) String() string {
+	var builder strings.Builder
+	builder.WriteString("NullableBook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nb.ID))
+	builder.WriteString("isbn=")
+	builder.WriteString(nb.Isbn)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(nb.Title)
+	builder.WriteString(", ")
+	if v := nb.Subtitle; v != nil {
+		builder.WriteString("subtitle=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := nb.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(nb.Author)
+	builder.WriteString(", ")
+	builder.WriteString("genre=")
+	builder.WriteString(nb.Genre)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", nb.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("publicized_at=")
+	builder.WriteString(nb.PublicizedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := nb.DiscontinuedAt; v != nil {
+		builder.WriteString("discontinued_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NullableBooks is a parsable slice of NullableBook.
+type NullableBooks []*NullableBook
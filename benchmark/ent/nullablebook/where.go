@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package nullablebook
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLTE(FieldID, id))
+}
+
+// Isbn applies equality check predicate on the "isbn" field. It's identical to IsbnEQ.
+func Isbn(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldIsbn, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldTitle, v))
+}
+
+// Subtitle applies equality check predicate on the "subtitle" field. It's identical to SubtitleEQ.
+func Subtitle(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldSubtitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldDescription, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldAuthor, v))
+}
+
+// Genre applies equality check predicate on the "genre" field. It's identical to GenreEQ.
+func Genre(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldGenre, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldQuantity, v))
+}
+
+// PublicizedAt applies equality check predicate on the "publicized_at" field. It's identical to PublicizedAtEQ.
+func PublicizedAt(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldPublicizedAt, v))
+}
+
+// DiscontinuedAt applies equality check predicate on the "discontinued_at" field. It's identical to DiscontinuedAtEQ.
+func DiscontinuedAt(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldDiscontinuedAt, v))
+}
+
+// IsbnEQ applies the EQ predicate on the "isbn" field.
+func IsbnEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldIsbn, v))
+}
+
+// IsbnNEQ applies the NEQ predicate on the "isbn" field.
+func IsbnNEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNEQ(FieldIsbn, v))
+}
+
+// IsbnIn applies the In predicate on the "isbn" field.
+func IsbnIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIn(FieldIsbn, vs...))
+}
+
+// IsbnNotIn applies the NotIn predicate on the "isbn" field.
+func IsbnNotIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotIn(FieldIsbn, vs...))
+}
+
+// IsbnGT applies the GT predicate on the "isbn" field.
+func IsbnGT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGT(FieldIsbn, v))
+}
+
+// IsbnGTE applies the GTE predicate on the "isbn" field.
+func IsbnGTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGTE(FieldIsbn, v))
+}
+
+// IsbnLT applies the LT predicate on the "isbn" field.
+func IsbnLT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLT(FieldIsbn, v))
+}
+
+// IsbnLTE applies the LTE predicate on the "isbn" field.
+func IsbnLTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLTE(FieldIsbn, v))
+}
+
+// IsbnContains applies the Contains predicate on the "isbn" field.
+func IsbnContains(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContains(FieldIsbn, v))
+}
+
+// IsbnHasPrefix applies the HasPrefix predicate on the "isbn" field.
+func IsbnHasPrefix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasPrefix(FieldIsbn, v))
+}
+
+// IsbnHasSuffix applies the HasSuffix predicate on the "isbn" field.
+func IsbnHasSuffix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasSuffix(FieldIsbn, v))
+}
+
+// IsbnEqualFold applies the EqualFold predicate on the "isbn" field.
+func IsbnEqualFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEqualFold(FieldIsbn, v))
+}
+
+// IsbnContainsFold applies the ContainsFold predicate on the "isbn" field.
+func IsbnContainsFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContainsFold(FieldIsbn, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// SubtitleEQ applies the EQ predicate on the "subtitle" field.
+func SubtitleEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldSubtitle, v))
+}
+
+// SubtitleNEQ applies the NEQ predicate on the "subtitle" field.
+func SubtitleNEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNEQ(FieldSubtitle, v))
+}
+
+// SubtitleIn applies the In predicate on the "subtitle" field.
+func SubtitleIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIn(FieldSubtitle, vs...))
+}
+
+// SubtitleNotIn applies the NotIn predicate on the "subtitle" field.
+func SubtitleNotIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotIn(FieldSubtitle, vs...))
+}
+
+// SubtitleGT applies the GT predicate on the "subtitle" field.
+func SubtitleGT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGT(FieldSubtitle, v))
+}
+
+// SubtitleGTE applies the GTE predicate on the "subtitle" field.
+func SubtitleGTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGTE(FieldSubtitle, v))
+}
+
+// SubtitleLT applies the LT predicate on the "subtitle" field.
+func SubtitleLT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLT(FieldSubtitle, v))
+}
+
+// SubtitleLTE applies the LTE predicate on the "subtitle" field.
+func SubtitleLTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLTE(FieldSubtitle, v))
+}
+
+// SubtitleContains applies the Contains predicate on the "subtitle" field.
+func SubtitleContains(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContains(FieldSubtitle, v))
+}
+
+// SubtitleHasPrefix applies the HasPrefix predicate on the "subtitle" field.
+func SubtitleHasPrefix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasPrefix(FieldSubtitle, v))
+}
+
+// SubtitleHasSuffix applies the HasSuffix predicate on the "subtitle" field.
+func SubtitleHasSuffix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasSuffix(FieldSubtitle, v))
+}
+
+// SubtitleIsNil applies the IsNil predicate on the "subtitle" field.
+func SubtitleIsNil() predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIsNull(FieldSubtitle))
+}
+
+// SubtitleNotNil applies the NotNil predicate on the "subtitle" field.
+func SubtitleNotNil() predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotNull(FieldSubtitle))
+}
+
+// SubtitleEqualFold applies the EqualFold predicate on the "subtitle" field.
+func SubtitleEqualFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEqualFold(FieldSubtitle, v))
+}
+
+// SubtitleContainsFold applies the ContainsFold predicate on the "subtitle" field.
+func SubtitleContainsFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContainsFold(FieldSubtitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// GenreEQ applies the EQ predicate on the "genre" field.
+func GenreEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldGenre, v))
+}
+
+// GenreNEQ applies the NEQ predicate on the "genre" field.
+func GenreNEQ(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNEQ(FieldGenre, v))
+}
+
+// GenreIn applies the In predicate on the "genre" field.
+func GenreIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIn(FieldGenre, vs...))
+}
+
+// GenreNotIn applies the NotIn predicate on the "genre" field.
+func GenreNotIn(vs ...string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotIn(FieldGenre, vs...))
+}
+
+// GenreGT applies the GT predicate on the "genre" field.
+func GenreGT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGT(FieldGenre, v))
+}
+
+// GenreGTE applies the GTE predicate on the "genre" field.
+func GenreGTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGTE(FieldGenre, v))
+}
+
+// GenreLT applies the LT predicate on the "genre" field.
+func GenreLT(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLT(FieldGenre, v))
+}
+
+// GenreLTE applies the LTE predicate on the "genre" field.
+func GenreLTE(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLTE(FieldGenre, v))
+}
+
+// GenreContains applies the Contains predicate on the "genre" field.
+func GenreContains(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContains(FieldGenre, v))
+}
+
+// GenreHasPrefix applies the HasPrefix predicate on the "genre" field.
+func GenreHasPrefix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasPrefix(FieldGenre, v))
+}
+
+// GenreHasSuffix applies the HasSuffix predicate on the "genre" field.
+func GenreHasSuffix(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldHasSuffix(FieldGenre, v))
+}
+
+// GenreEqualFold applies the EqualFold predicate on the "genre" field.
+func GenreEqualFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEqualFold(FieldGenre, v))
+}
+
+// GenreContainsFold applies the ContainsFold predicate on the "genre" field.
+func GenreContainsFold(v string) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldContainsFold(FieldGenre, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLTE(FieldQuantity, v))
+}
+
+// PublicizedAtEQ applies the EQ predicate on the "publicized_at" field.
+func PublicizedAtEQ(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldPublicizedAt, v))
+}
+
+// PublicizedAtNEQ applies the NEQ predicate on the "publicized_at" field.
+func PublicizedAtNEQ(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNEQ(FieldPublicizedAt, v))
+}
+
+// PublicizedAtIn applies the In predicate on the "publicized_at" field.
+func PublicizedAtIn(vs ...time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIn(FieldPublicizedAt, vs...))
+}
+
+// PublicizedAtNotIn applies the NotIn predicate on the "publicized_at" field.
+func PublicizedAtNotIn(vs ...time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotIn(FieldPublicizedAt, vs...))
+}
+
+// PublicizedAtGT applies the GT predicate on the "publicized_at" field.
+func PublicizedAtGT(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGT(FieldPublicizedAt, v))
+}
+
+// PublicizedAtGTE applies the GTE predicate on the "publicized_at" field.
+func PublicizedAtGTE(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGTE(FieldPublicizedAt, v))
+}
+
+// PublicizedAtLT applies the LT predicate on the "publicized_at" field.
+func PublicizedAtLT(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLT(FieldPublicizedAt, v))
+}
+
+// PublicizedAtLTE applies the LTE predicate on the "publicized_at" field.
+func PublicizedAtLTE(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLTE(FieldPublicizedAt, v))
+}
+
+// DiscontinuedAtEQ applies the EQ predicate on the "discontinued_at" field.
+func DiscontinuedAtEQ(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldEQ(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtNEQ applies the NEQ predicate on the "discontinued_at" field.
+func DiscontinuedAtNEQ(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNEQ(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtIn applies the In predicate on the "discontinued_at" field.
+func DiscontinuedAtIn(vs ...time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIn(FieldDiscontinuedAt, vs...))
+}
+
+// DiscontinuedAtNotIn applies the NotIn predicate on the "discontinued_at" field.
+func DiscontinuedAtNotIn(vs ...time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotIn(FieldDiscontinuedAt, vs...))
+}
+
+// DiscontinuedAtGT applies the GT predicate on the "discontinued_at" field.
+func DiscontinuedAtGT(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGT(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtGTE applies the GTE predicate on the "discontinued_at" field.
+func DiscontinuedAtGTE(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldGTE(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtLT applies the LT predicate on the "discontinued_at" field.
+func DiscontinuedAtLT(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLT(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtLTE applies the LTE predicate on the "discontinued_at" field.
+func DiscontinuedAtLTE(v time.Time) predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldLTE(FieldDiscontinuedAt, v))
+}
+
+// DiscontinuedAtIsNil applies the IsNil predicate on the "discontinued_at" field.
+func DiscontinuedAtIsNil() predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldIsNull(FieldDiscontinuedAt))
+}
+
+// DiscontinuedAtNotNil applies the NotNil predicate on the "discontinued_at" field.
+func DiscontinuedAtNotNil() predicate.NullableBook {
+	return predicate.NullableBook(sql.FieldNotNull(FieldDiscontinuedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NullableBook) predicate.NullableBook {
+	return predicate.NullableBook(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NullableBook) predicate.NullableBook {
+	return predicate.NullableBook(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NullableBook) predicate.NullableBook {
+	return predicate.NullableBook(sql.NotPredicates(p))
+}
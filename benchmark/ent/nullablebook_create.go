@@ -0,0 +1,974 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/nullablebook"
+)
+
+// NullableBookCreate is the builder for creating a NullableBook entity.
+type NullableBookCreate struct {
+	config
+	mutation *NullableBookMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetIsbn sets the "isbn" field.
+func (nbc *NullableBookCreate) SetIsbn(s string) *NullableBookCreate {
+	nbc.mutation.SetIsbn(s)
+	return nbc
+}
+
+// SetTitle sets the "title" field.
+func (nbc *NullableBookCreate) SetTitle(s string) *NullableBookCreate {
+	nbc.mutation.SetTitle(s)
+	return nbc
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (nbc *NullableBookCreate) SetSubtitle(s string) *NullableBookCreate {
+	nbc.mutation.SetSubtitle(s)
+	return nbc
+}
+
+// SetNillableSubtitle sets the "subtitle" field if the given value is not nil.
+func (nbc *NullableBookCreate) SetNillableSubtitle(s *string) *NullableBookCreate {
+	if s != nil {
+		nbc.SetSubtitle(*s)
+	}
+	return nbc
+}
+
+// SetDescription sets the "description" field.
+func (nbc *NullableBookCreate) SetDescription(s string) *NullableBookCreate {
+	nbc.mutation.SetDescription(s)
+	return nbc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (nbc *NullableBookCreate) SetNillableDescription(s *string) *NullableBookCreate {
+	if s != nil {
+		nbc.SetDescription(*s)
+	}
+	return nbc
+}
+
+// SetAuthor sets the "author" field.
+func (nbc *NullableBookCreate) SetAuthor(s string) *NullableBookCreate {
+	nbc.mutation.SetAuthor(s)
+	return nbc
+}
+
+// SetGenre sets the "genre" field.
+func (nbc *NullableBookCreate) SetGenre(s string) *NullableBookCreate {
+	nbc.mutation.SetGenre(s)
+	return nbc
+}
+
+// SetQuantity sets the "quantity" field.
+func (nbc *NullableBookCreate) SetQuantity(i int) *NullableBookCreate {
+	nbc.mutation.SetQuantity(i)
+	return nbc
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (nbc *NullableBookCreate) SetPublicizedAt(t time.Time) *NullableBookCreate {
+	nbc.mutation.SetPublicizedAt(t)
+	return nbc
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (nbc *NullableBookCreate) SetDiscontinuedAt(t time.Time) *NullableBookCreate {
+	nbc.mutation.SetDiscontinuedAt(t)
+	return nbc
+}
+
+// SetNillableDiscontinuedAt sets the "discontinued_at" field if the given value is not nil.
+func (nbc *NullableBookCreate) SetNillableDiscontinuedAt(t *time.Time) *NullableBookCreate {
+	if t != nil {
+		nbc.SetDiscontinuedAt(*t)
+	}
+	return nbc
+}
+
+// Mutation returns the NullableBookMutation object of the builder.
+func (nbc *NullableBookCreate) Mutation() *NullableBookMutation {
+	return nbc.mutation
+}
+
+// Save creates the NullableBook in the database.
+func (nbc *NullableBookCreate) Save(ctx context.Context) (*NullableBook, error) {
+	return withHooks(ctx, nbc.sqlSave, nbc.mutation, nbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nbc *NullableBookCreate) SaveX(ctx context.Context) *NullableBook {
+	v, err := nbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nbc *NullableBookCreate) Exec(ctx context.Context) error {
+	_, err := nbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nbc *NullableBookCreate) ExecX(ctx context.Context) {
+	if err := nbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nbc *NullableBookCreate) check() error {
+	if _, ok := nbc.mutation.Isbn(); !ok {
+		return &ValidationError{Name: "isbn", err: errors.New(`ent: missing required field "NullableBook.isbn"`)}
+	}
+	if _, ok := nbc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "NullableBook.title"`)}
+	}
+	if _, ok := nbc.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "NullableBook.author"`)}
+	}
+	if _, ok := nbc.mutation.Genre(); !ok {
+		return &ValidationError{Name: "genre", err: errors.New(`ent: missing required field "NullableBook.genre"`)}
+	}
+	if _, ok := nbc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "NullableBook.quantity"`)}
+	}
+	if _, ok := nbc.mutation.PublicizedAt(); !ok {
+		return &ValidationError{Name: "publicized_at", err: errors.New(`ent: missing required field "NullableBook.publicized_at"`)}
+	}
+	return nil
+}
+
+func (nbc *NullableBookCreate) sqlSave(ctx context.Context) (*NullableBook, error) {
+	if err := nbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	nbc.mutation.id = &_node.ID
+	nbc.mutation.done = true
+	return _node, nil
+}
+
+func (nbc *NullableBookCreate) createSpec() (*NullableBook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NullableBook{config: nbc.config}
+		_spec = sqlgraph.NewCreateSpec(nullablebook.Table, sqlgraph.NewFieldSpec(nullablebook.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = nbc.conflict
+	if value, ok := nbc.mutation.Isbn(); ok {
+		_spec.SetField(nullablebook.FieldIsbn, field.TypeString, value)
+		_node.Isbn = value
+	}
+	if value, ok := nbc.mutation.Title(); ok {
+		_spec.SetField(nullablebook.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := nbc.mutation.Subtitle(); ok {
+		_spec.SetField(nullablebook.FieldSubtitle, field.TypeString, value)
+		_node.Subtitle = &value
+	}
+	if value, ok := nbc.mutation.Description(); ok {
+		_spec.SetField(nullablebook.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := nbc.mutation.Author(); ok {
+		_spec.SetField(nullablebook.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := nbc.mutation.Genre(); ok {
+		_spec.SetField(nullablebook.FieldGenre, field.TypeString, value)
+		_node.Genre = value
+	}
+	if value, ok := nbc.mutation.Quantity(); ok {
+		_spec.SetField(nullablebook.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := nbc.mutation.PublicizedAt(); ok {
+		_spec.SetField(nullablebook.FieldPublicizedAt, field.TypeTime, value)
+		_node.PublicizedAt = value
+	}
+	if value, ok := nbc.mutation.DiscontinuedAt(); ok {
+		_spec.SetField(nullablebook.FieldDiscontinuedAt, field.TypeTime, value)
+		_node.DiscontinuedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NullableBook.Create().
+//		SetIsbn(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NullableBookUpsert) {
+//			SetIsbn(v+v).
+//		}).
+//		Exec(ctx)
+func (nbc *NullableBookCreate) OnConflict(opts ...sql.ConflictOption) *NullableBookUpsertOne {
+	nbc.conflict = opts
+	return &NullableBookUpsertOne{
+		create: nbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NullableBook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nbc *NullableBookCreate) OnConflictColumns(columns ...string) *NullableBookUpsertOne {
+	nbc.conflict = append(nbc.conflict, sql.ConflictColumns(columns...))
+	return &NullableBookUpsertOne{
+		create: nbc,
+	}
+}
+
+type (
+	// NullableBookUpsertOne is the builder for "upsert"-ing
+	//  one NullableBook node.
+	NullableBookUpsertOne struct {
+		create *NullableBookCreate
+	}
+
+	// NullableBookUpsert is the "OnConflict" setter.
+	NullableBookUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetIsbn sets the "isbn" field.
+func (u *NullableBookUpsert) SetIsbn(v string) *NullableBookUpsert {
+	u.Set(nullablebook.FieldIsbn, v)
+	return u
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *NullableBookUpsert) UpdateIsbn() *NullableBookUpsert {
+	u.SetExcluded(nullablebook.FieldIsbn)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *NullableBookUpsert) SetTitle(v string) *NullableBookUpsert {
+	u.Set(nullablebook.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NullableBookUpsert) UpdateTitle() *NullableBookUpsert {
+	u.SetExcluded(nullablebook.FieldTitle)
+	return u
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *NullableBookUpsert) SetSubtitle(v string) *NullableBookUpsert {
+	u.Set(nullablebook.FieldSubtitle, v)
+	return u
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *NullableBookUpsert) UpdateSubtitle() *NullableBookUpsert {
+	u.SetExcluded(nullablebook.FieldSubtitle)
+	return u
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *NullableBookUpsert) ClearSubtitle() *NullableBookUpsert {
+	u.SetNull(nullablebook.FieldSubtitle)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *NullableBookUpsert) SetDescription(v string) *NullableBookUpsert {
+	u.Set(nullablebook.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *NullableBookUpsert) UpdateDescription() *NullableBookUpsert {
+	u.SetExcluded(nullablebook.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *NullableBookUpsert) ClearDescription() *NullableBookUpsert {
+	u.SetNull(nullablebook.FieldDescription)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *NullableBookUpsert) SetAuthor(v string) *NullableBookUpsert {
+	u.Set(nullablebook.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *NullableBookUpsert) UpdateAuthor() *NullableBookUpsert {
+	u.SetExcluded(nullablebook.FieldAuthor)
+	return u
+}
+
+// SetGenre sets the "genre" field.
+func (u *NullableBookUpsert) SetGenre(v string) *NullableBookUpsert {
+	u.Set(nullablebook.FieldGenre, v)
+	return u
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *NullableBookUpsert) UpdateGenre() *NullableBookUpsert {
+	u.SetExcluded(nullablebook.FieldGenre)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *NullableBookUpsert) SetQuantity(v int) *NullableBookUpsert {
+	u.Set(nullablebook.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *NullableBookUpsert) UpdateQuantity() *NullableBookUpsert {
+	u.SetExcluded(nullablebook.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *NullableBookUpsert) AddQuantity(v int) *NullableBookUpsert {
+	u.Add(nullablebook.FieldQuantity, v)
+	return u
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *NullableBookUpsert) SetPublicizedAt(v time.Time) *NullableBookUpsert {
+	u.Set(nullablebook.FieldPublicizedAt, v)
+	return u
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *NullableBookUpsert) UpdatePublicizedAt() *NullableBookUpsert {
+	u.SetExcluded(nullablebook.FieldPublicizedAt)
+	return u
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (u *NullableBookUpsert) SetDiscontinuedAt(v time.Time) *NullableBookUpsert {
+	u.Set(nullablebook.FieldDiscontinuedAt, v)
+	return u
+}
+
+// UpdateDiscontinuedAt sets the "discontinued_at" field to the value that was provided on create.
+func (u *NullableBookUpsert) UpdateDiscontinuedAt() *NullableBookUpsert {
+	u.SetExcluded(nullablebook.FieldDiscontinuedAt)
+	return u
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (u *NullableBookUpsert) ClearDiscontinuedAt() *NullableBookUpsert {
+	u.SetNull(nullablebook.FieldDiscontinuedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.NullableBook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NullableBookUpsertOne) UpdateNewValues() *NullableBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NullableBook.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *NullableBookUpsertOne) Ignore() *NullableBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NullableBookUpsertOne) DoNothing() *NullableBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NullableBookCreate.OnConflict
+// documentation for more info.
+func (u *NullableBookUpsertOne) Update(set func(*NullableBookUpsert)) *NullableBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NullableBookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetIsbn sets the "isbn" field.
+func (u *NullableBookUpsertOne) SetIsbn(v string) *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetIsbn(v)
+	})
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *NullableBookUpsertOne) UpdateIsbn() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateIsbn()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *NullableBookUpsertOne) SetTitle(v string) *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NullableBookUpsertOne) UpdateTitle() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *NullableBookUpsertOne) SetSubtitle(v string) *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetSubtitle(v)
+	})
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *NullableBookUpsertOne) UpdateSubtitle() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateSubtitle()
+	})
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *NullableBookUpsertOne) ClearSubtitle() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.ClearSubtitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *NullableBookUpsertOne) SetDescription(v string) *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *NullableBookUpsertOne) UpdateDescription() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *NullableBookUpsertOne) ClearDescription() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *NullableBookUpsertOne) SetAuthor(v string) *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *NullableBookUpsertOne) UpdateAuthor() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetGenre sets the "genre" field.
+func (u *NullableBookUpsertOne) SetGenre(v string) *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetGenre(v)
+	})
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *NullableBookUpsertOne) UpdateGenre() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateGenre()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *NullableBookUpsertOne) SetQuantity(v int) *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *NullableBookUpsertOne) AddQuantity(v int) *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *NullableBookUpsertOne) UpdateQuantity() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *NullableBookUpsertOne) SetPublicizedAt(v time.Time) *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetPublicizedAt(v)
+	})
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *NullableBookUpsertOne) UpdatePublicizedAt() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdatePublicizedAt()
+	})
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (u *NullableBookUpsertOne) SetDiscontinuedAt(v time.Time) *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetDiscontinuedAt(v)
+	})
+}
+
+// UpdateDiscontinuedAt sets the "discontinued_at" field to the value that was provided on create.
+func (u *NullableBookUpsertOne) UpdateDiscontinuedAt() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateDiscontinuedAt()
+	})
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (u *NullableBookUpsertOne) ClearDiscontinuedAt() *NullableBookUpsertOne {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.ClearDiscontinuedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NullableBookUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NullableBookCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NullableBookUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *NullableBookUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *NullableBookUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// NullableBookCreateBulk is the builder for creating many NullableBook entities in bulk.
+type NullableBookCreateBulk struct {
+	config
+	err      error
+	builders []*NullableBookCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the NullableBook entities in the database.
+func (nbcb *NullableBookCreateBulk) Save(ctx context.Context) ([]*NullableBook, error) {
+	if nbcb.err != nil {
+		return nil, nbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(nbcb.builders))
+	nodes := make([]*NullableBook, len(nbcb.builders))
+	mutators := make([]Mutator, len(nbcb.builders))
+	for i := range nbcb.builders {
+		func(i int, root context.Context) {
+			builder := nbcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NullableBookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = nbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nbcb *NullableBookCreateBulk) SaveX(ctx context.Context) []*NullableBook {
+	v, err := nbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nbcb *NullableBookCreateBulk) Exec(ctx context.Context) error {
+	_, err := nbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nbcb *NullableBookCreateBulk) ExecX(ctx context.Context) {
+	if err := nbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.NullableBook.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.NullableBookUpsert) {
+//			SetIsbn(v+v).
+//		}).
+//		Exec(ctx)
+func (nbcb *NullableBookCreateBulk) OnConflict(opts ...sql.ConflictOption) *NullableBookUpsertBulk {
+	nbcb.conflict = opts
+	return &NullableBookUpsertBulk{
+		create: nbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.NullableBook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (nbcb *NullableBookCreateBulk) OnConflictColumns(columns ...string) *NullableBookUpsertBulk {
+	nbcb.conflict = append(nbcb.conflict, sql.ConflictColumns(columns...))
+	return &NullableBookUpsertBulk{
+		create: nbcb,
+	}
+}
+
+// NullableBookUpsertBulk is the builder for "upsert"-ing
+// a bulk of NullableBook nodes.
+type NullableBookUpsertBulk struct {
+	create *NullableBookCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.NullableBook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *NullableBookUpsertBulk) UpdateNewValues() *NullableBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.NullableBook.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *NullableBookUpsertBulk) Ignore() *NullableBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *NullableBookUpsertBulk) DoNothing() *NullableBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the NullableBookCreateBulk.OnConflict
+// documentation for more info.
+func (u *NullableBookUpsertBulk) Update(set func(*NullableBookUpsert)) *NullableBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&NullableBookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetIsbn sets the "isbn" field.
+func (u *NullableBookUpsertBulk) SetIsbn(v string) *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetIsbn(v)
+	})
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *NullableBookUpsertBulk) UpdateIsbn() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateIsbn()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *NullableBookUpsertBulk) SetTitle(v string) *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *NullableBookUpsertBulk) UpdateTitle() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (u *NullableBookUpsertBulk) SetSubtitle(v string) *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetSubtitle(v)
+	})
+}
+
+// UpdateSubtitle sets the "subtitle" field to the value that was provided on create.
+func (u *NullableBookUpsertBulk) UpdateSubtitle() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateSubtitle()
+	})
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (u *NullableBookUpsertBulk) ClearSubtitle() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.ClearSubtitle()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *NullableBookUpsertBulk) SetDescription(v string) *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *NullableBookUpsertBulk) UpdateDescription() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *NullableBookUpsertBulk) ClearDescription() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *NullableBookUpsertBulk) SetAuthor(v string) *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *NullableBookUpsertBulk) UpdateAuthor() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetGenre sets the "genre" field.
+func (u *NullableBookUpsertBulk) SetGenre(v string) *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetGenre(v)
+	})
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *NullableBookUpsertBulk) UpdateGenre() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateGenre()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *NullableBookUpsertBulk) SetQuantity(v int) *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *NullableBookUpsertBulk) AddQuantity(v int) *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *NullableBookUpsertBulk) UpdateQuantity() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *NullableBookUpsertBulk) SetPublicizedAt(v time.Time) *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetPublicizedAt(v)
+	})
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *NullableBookUpsertBulk) UpdatePublicizedAt() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdatePublicizedAt()
+	})
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (u *NullableBookUpsertBulk) SetDiscontinuedAt(v time.Time) *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.SetDiscontinuedAt(v)
+	})
+}
+
+// UpdateDiscontinuedAt sets the "discontinued_at" field to the value that was provided on create.
+func (u *NullableBookUpsertBulk) UpdateDiscontinuedAt() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.UpdateDiscontinuedAt()
+	})
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (u *NullableBookUpsertBulk) ClearDiscontinuedAt() *NullableBookUpsertBulk {
+	return u.Update(func(s *NullableBookUpsert) {
+		s.ClearDiscontinuedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *NullableBookUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the NullableBookCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for NullableBookCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *NullableBookUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/nullablebook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/predicate"
+)
+
+// NullableBookDelete is the builder for deleting a NullableBook entity.
+type NullableBookDelete struct {
+	config
+	hooks    []Hook
+	mutation *NullableBookMutation
+}
+
+// Where appends a list predicates to the NullableBookDelete builder.
+func (nbd *NullableBookDelete) Where(ps ...predicate.NullableBook) *NullableBookDelete {
+	nbd.mutation.Where(ps...)
+	return nbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nbd *NullableBookDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, nbd.sqlExec, nbd.mutation, nbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nbd *NullableBookDelete) ExecX(ctx context.Context) int {
+	n, err := nbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nbd *NullableBookDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(nullablebook.Table, sqlgraph.NewFieldSpec(nullablebook.FieldID, field.TypeInt))
+	if ps := nbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, nbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	nbd.mutation.done = true
+	return affected, err
+}
+
+// NullableBookDeleteOne is the builder for deleting a single NullableBook entity.
+type NullableBookDeleteOne struct {
+	nbd *NullableBookDelete
+}
+
+// Where appends a list predicates to the NullableBookDelete builder.
+func (nbdo *NullableBookDeleteOne) Where(ps ...predicate.NullableBook) *NullableBookDeleteOne {
+	nbdo.nbd.mutation.Where(ps...)
+	return nbdo
+}
+
+// Exec executes the deletion query.
+func (nbdo *NullableBookDeleteOne) Exec(ctx context.Context) error {
+	n, err := nbdo.nbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{nullablebook.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nbdo *NullableBookDeleteOne) ExecX(ctx context.Context) {
+	if err := nbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,556 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/nullablebook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/predicate"
+)
+
+// NullableBookUpdate is the builder for updating NullableBook entities.
+type NullableBookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NullableBookMutation
+}
+
+// Where appends a list predicates to the NullableBookUpdate builder.
+func (nbu *NullableBookUpdate) Where(ps ...predicate.NullableBook) *NullableBookUpdate {
+	nbu.mutation.Where(ps...)
+	return nbu
+}
+
+// SetIsbn sets the "isbn" field.
+func (nbu *NullableBookUpdate) SetIsbn(s string) *NullableBookUpdate {
+	nbu.mutation.SetIsbn(s)
+	return nbu
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (nbu *NullableBookUpdate) SetNillableIsbn(s *string) *NullableBookUpdate {
+	if s != nil {
+		nbu.SetIsbn(*s)
+	}
+	return nbu
+}
+
+// SetTitle sets the "title" field.
+func (nbu *NullableBookUpdate) SetTitle(s string) *NullableBookUpdate {
+	nbu.mutation.SetTitle(s)
+	return nbu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nbu *NullableBookUpdate) SetNillableTitle(s *string) *NullableBookUpdate {
+	if s != nil {
+		nbu.SetTitle(*s)
+	}
+	return nbu
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (nbu *NullableBookUpdate) SetSubtitle(s string) *NullableBookUpdate {
+	nbu.mutation.SetSubtitle(s)
+	return nbu
+}
+
+// SetNillableSubtitle sets the "subtitle" field if the given value is not nil.
+func (nbu *NullableBookUpdate) SetNillableSubtitle(s *string) *NullableBookUpdate {
+	if s != nil {
+		nbu.SetSubtitle(*s)
+	}
+	return nbu
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (nbu *NullableBookUpdate) ClearSubtitle() *NullableBookUpdate {
+	nbu.mutation.ClearSubtitle()
+	return nbu
+}
+
+// SetDescription sets the "description" field.
+func (nbu *NullableBookUpdate) SetDescription(s string) *NullableBookUpdate {
+	nbu.mutation.SetDescription(s)
+	return nbu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (nbu *NullableBookUpdate) SetNillableDescription(s *string) *NullableBookUpdate {
+	if s != nil {
+		nbu.SetDescription(*s)
+	}
+	return nbu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (nbu *NullableBookUpdate) ClearDescription() *NullableBookUpdate {
+	nbu.mutation.ClearDescription()
+	return nbu
+}
+
+// SetAuthor sets the "author" field.
+func (nbu *NullableBookUpdate) SetAuthor(s string) *NullableBookUpdate {
+	nbu.mutation.SetAuthor(s)
+	return nbu
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (nbu *NullableBookUpdate) SetNillableAuthor(s *string) *NullableBookUpdate {
+	if s != nil {
+		nbu.SetAuthor(*s)
+	}
+	return nbu
+}
+
+// SetGenre sets the "genre" field.
+func (nbu *NullableBookUpdate) SetGenre(s string) *NullableBookUpdate {
+	nbu.mutation.SetGenre(s)
+	return nbu
+}
+
+// SetNillableGenre sets the "genre" field if the given value is not nil.
+func (nbu *NullableBookUpdate) SetNillableGenre(s *string) *NullableBookUpdate {
+	if s != nil {
+		nbu.SetGenre(*s)
+	}
+	return nbu
+}
+
+// SetQuantity sets the "quantity" field.
+func (nbu *NullableBookUpdate) SetQuantity(i int) *NullableBookUpdate {
+	nbu.mutation.ResetQuantity()
+	nbu.mutation.SetQuantity(i)
+	return nbu
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (nbu *NullableBookUpdate) SetNillableQuantity(i *int) *NullableBookUpdate {
+	if i != nil {
+		nbu.SetQuantity(*i)
+	}
+	return nbu
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (nbu *NullableBookUpdate) AddQuantity(i int) *NullableBookUpdate {
+	nbu.mutation.AddQuantity(i)
+	return nbu
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (nbu *NullableBookUpdate) SetPublicizedAt(t time.Time) *NullableBookUpdate {
+	nbu.mutation.SetPublicizedAt(t)
+	return nbu
+}
+
+// SetNillablePublicizedAt sets the "publicized_at" field if the given value is not nil.
+func (nbu *NullableBookUpdate) SetNillablePublicizedAt(t *time.Time) *NullableBookUpdate {
+	if t != nil {
+		nbu.SetPublicizedAt(*t)
+	}
+	return nbu
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (nbu *NullableBookUpdate) SetDiscontinuedAt(t time.Time) *NullableBookUpdate {
+	nbu.mutation.SetDiscontinuedAt(t)
+	return nbu
+}
+
+// SetNillableDiscontinuedAt sets the "discontinued_at" field if the given value is not nil.
+func (nbu *NullableBookUpdate) SetNillableDiscontinuedAt(t *time.Time) *NullableBookUpdate {
+	if t != nil {
+		nbu.SetDiscontinuedAt(*t)
+	}
+	return nbu
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (nbu *NullableBookUpdate) ClearDiscontinuedAt() *NullableBookUpdate {
+	nbu.mutation.ClearDiscontinuedAt()
+	return nbu
+}
+
+// Mutation returns the NullableBookMutation object of the builder.
+func (nbu *NullableBookUpdate) Mutation() *NullableBookMutation {
+	return nbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nbu *NullableBookUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nbu.sqlSave, nbu.mutation, nbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nbu *NullableBookUpdate) SaveX(ctx context.Context) int {
+	affected, err := nbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nbu *NullableBookUpdate) Exec(ctx context.Context) error {
+	_, err := nbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nbu *NullableBookUpdate) ExecX(ctx context.Context) {
+	if err := nbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (nbu *NullableBookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(nullablebook.Table, nullablebook.Columns, sqlgraph.NewFieldSpec(nullablebook.FieldID, field.TypeInt))
+	if ps := nbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nbu.mutation.Isbn(); ok {
+		_spec.SetField(nullablebook.FieldIsbn, field.TypeString, value)
+	}
+	if value, ok := nbu.mutation.Title(); ok {
+		_spec.SetField(nullablebook.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nbu.mutation.Subtitle(); ok {
+		_spec.SetField(nullablebook.FieldSubtitle, field.TypeString, value)
+	}
+	if nbu.mutation.SubtitleCleared() {
+		_spec.ClearField(nullablebook.FieldSubtitle, field.TypeString)
+	}
+	if value, ok := nbu.mutation.Description(); ok {
+		_spec.SetField(nullablebook.FieldDescription, field.TypeString, value)
+	}
+	if nbu.mutation.DescriptionCleared() {
+		_spec.ClearField(nullablebook.FieldDescription, field.TypeString)
+	}
+	if value, ok := nbu.mutation.Author(); ok {
+		_spec.SetField(nullablebook.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := nbu.mutation.Genre(); ok {
+		_spec.SetField(nullablebook.FieldGenre, field.TypeString, value)
+	}
+	if value, ok := nbu.mutation.Quantity(); ok {
+		_spec.SetField(nullablebook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := nbu.mutation.AddedQuantity(); ok {
+		_spec.AddField(nullablebook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := nbu.mutation.PublicizedAt(); ok {
+		_spec.SetField(nullablebook.FieldPublicizedAt, field.TypeTime, value)
+	}
+	if value, ok := nbu.mutation.DiscontinuedAt(); ok {
+		_spec.SetField(nullablebook.FieldDiscontinuedAt, field.TypeTime, value)
+	}
+	if nbu.mutation.DiscontinuedAtCleared() {
+		_spec.ClearField(nullablebook.FieldDiscontinuedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nullablebook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nbu.mutation.done = true
+	return n, nil
+}
+
+// NullableBookUpdateOne is the builder for updating a single NullableBook entity.
+type NullableBookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NullableBookMutation
+}
+
+// SetIsbn sets the "isbn" field.
+func (nbuo *NullableBookUpdateOne) SetIsbn(s string) *NullableBookUpdateOne {
+	nbuo.mutation.SetIsbn(s)
+	return nbuo
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (nbuo *NullableBookUpdateOne) SetNillableIsbn(s *string) *NullableBookUpdateOne {
+	if s != nil {
+		nbuo.SetIsbn(*s)
+	}
+	return nbuo
+}
+
+// SetTitle sets the "title" field.
+func (nbuo *NullableBookUpdateOne) SetTitle(s string) *NullableBookUpdateOne {
+	nbuo.mutation.SetTitle(s)
+	return nbuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nbuo *NullableBookUpdateOne) SetNillableTitle(s *string) *NullableBookUpdateOne {
+	if s != nil {
+		nbuo.SetTitle(*s)
+	}
+	return nbuo
+}
+
+// SetSubtitle sets the "subtitle" field.
+func (nbuo *NullableBookUpdateOne) SetSubtitle(s string) *NullableBookUpdateOne {
+	nbuo.mutation.SetSubtitle(s)
+	return nbuo
+}
+
+// SetNillableSubtitle sets the "subtitle" field if the given value is not nil.
+func (nbuo *NullableBookUpdateOne) SetNillableSubtitle(s *string) *NullableBookUpdateOne {
+	if s != nil {
+		nbuo.SetSubtitle(*s)
+	}
+	return nbuo
+}
+
+// ClearSubtitle clears the value of the "subtitle" field.
+func (nbuo *NullableBookUpdateOne) ClearSubtitle() *NullableBookUpdateOne {
+	nbuo.mutation.ClearSubtitle()
+	return nbuo
+}
+
+// SetDescription sets the "description" field.
+func (nbuo *NullableBookUpdateOne) SetDescription(s string) *NullableBookUpdateOne {
+	nbuo.mutation.SetDescription(s)
+	return nbuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (nbuo *NullableBookUpdateOne) SetNillableDescription(s *string) *NullableBookUpdateOne {
+	if s != nil {
+		nbuo.SetDescription(*s)
+	}
+	return nbuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (nbuo *NullableBookUpdateOne) ClearDescription() *NullableBookUpdateOne {
+	nbuo.mutation.ClearDescription()
+	return nbuo
+}
+
+// SetAuthor sets the "author" field.
+func (nbuo *NullableBookUpdateOne) SetAuthor(s string) *NullableBookUpdateOne {
+	nbuo.mutation.SetAuthor(s)
+	return nbuo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (nbuo *NullableBookUpdateOne) SetNillableAuthor(s *string) *NullableBookUpdateOne {
+	if s != nil {
+		nbuo.SetAuthor(*s)
+	}
+	return nbuo
+}
+
+// SetGenre sets the "genre" field.
+func (nbuo *NullableBookUpdateOne) SetGenre(s string) *NullableBookUpdateOne {
+	nbuo.mutation.SetGenre(s)
+	return nbuo
+}
+
+// SetNillableGenre sets the "genre" field if the given value is not nil.
+func (nbuo *NullableBookUpdateOne) SetNillableGenre(s *string) *NullableBookUpdateOne {
+	if s != nil {
+		nbuo.SetGenre(*s)
+	}
+	return nbuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (nbuo *NullableBookUpdateOne) SetQuantity(i int) *NullableBookUpdateOne {
+	nbuo.mutation.ResetQuantity()
+	nbuo.mutation.SetQuantity(i)
+	return nbuo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (nbuo *NullableBookUpdateOne) SetNillableQuantity(i *int) *NullableBookUpdateOne {
+	if i != nil {
+		nbuo.SetQuantity(*i)
+	}
+	return nbuo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (nbuo *NullableBookUpdateOne) AddQuantity(i int) *NullableBookUpdateOne {
+	nbuo.mutation.AddQuantity(i)
+	return nbuo
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (nbuo *NullableBookUpdateOne) SetPublicizedAt(t time.Time) *NullableBookUpdateOne {
+	nbuo.mutation.SetPublicizedAt(t)
+	return nbuo
+}
+
+// SetNillablePublicizedAt sets the "publicized_at" field if the given value is not nil.
+func (nbuo *NullableBookUpdateOne) SetNillablePublicizedAt(t *time.Time) *NullableBookUpdateOne {
+	if t != nil {
+		nbuo.SetPublicizedAt(*t)
+	}
+	return nbuo
+}
+
+// SetDiscontinuedAt sets the "discontinued_at" field.
+func (nbuo *NullableBookUpdateOne) SetDiscontinuedAt(t time.Time) *NullableBookUpdateOne {
+	nbuo.mutation.SetDiscontinuedAt(t)
+	return nbuo
+}
+
+// SetNillableDiscontinuedAt sets the "discontinued_at" field if the given value is not nil.
+func (nbuo *NullableBookUpdateOne) SetNillableDiscontinuedAt(t *time.Time) *NullableBookUpdateOne {
+	if t != nil {
+		nbuo.SetDiscontinuedAt(*t)
+	}
+	return nbuo
+}
+
+// ClearDiscontinuedAt clears the value of the "discontinued_at" field.
+func (nbuo *NullableBookUpdateOne) ClearDiscontinuedAt() *NullableBookUpdateOne {
+	nbuo.mutation.ClearDiscontinuedAt()
+	return nbuo
+}
+
+// Mutation returns the NullableBookMutation object of the builder.
+func (nbuo *NullableBookUpdateOne) Mutation() *NullableBookMutation {
+	return nbuo.mutation
+}
+
+// Where appends a list predicates to the NullableBookUpdate builder.
+func (nbuo *NullableBookUpdateOne) Where(ps ...predicate.NullableBook) *NullableBookUpdateOne {
+	nbuo.mutation.Where(ps...)
+	return nbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nbuo *NullableBookUpdateOne) Select(field string, fields ...string) *NullableBookUpdateOne {
+	nbuo.fields = append([]string{field}, fields...)
+	return nbuo
+}
+
+// Save executes the query and returns the updated NullableBook entity.
+func (nbuo *NullableBookUpdateOne) Save(ctx context.Context) (*NullableBook, error) {
+	return withHooks(ctx, nbuo.sqlSave, nbuo.mutation, nbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nbuo *NullableBookUpdateOne) SaveX(ctx context.Context) *NullableBook {
+	node, err := nbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nbuo *NullableBookUpdateOne) Exec(ctx context.Context) error {
+	_, err := nbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nbuo *NullableBookUpdateOne) ExecX(ctx context.Context) {
+	if err := nbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (nbuo *NullableBookUpdateOne) sqlSave(ctx context.Context) (_node *NullableBook, err error) {
+	_spec := sqlgraph.NewUpdateSpec(nullablebook.Table, nullablebook.Columns, sqlgraph.NewFieldSpec(nullablebook.FieldID, field.TypeInt))
+	id, ok := nbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NullableBook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nullablebook.FieldID)
+		for _, f := range fields {
+			if !nullablebook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != nullablebook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nbuo.mutation.Isbn(); ok {
+		_spec.SetField(nullablebook.FieldIsbn, field.TypeString, value)
+	}
+	if value, ok := nbuo.mutation.Title(); ok {
+		_spec.SetField(nullablebook.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nbuo.mutation.Subtitle(); ok {
+		_spec.SetField(nullablebook.FieldSubtitle, field.TypeString, value)
+	}
+	if nbuo.mutation.SubtitleCleared() {
+		_spec.ClearField(nullablebook.FieldSubtitle, field.TypeString)
+	}
+	if value, ok := nbuo.mutation.Description(); ok {
+		_spec.SetField(nullablebook.FieldDescription, field.TypeString, value)
+	}
+	if nbuo.mutation.DescriptionCleared() {
+		_spec.ClearField(nullablebook.FieldDescription, field.TypeString)
+	}
+	if value, ok := nbuo.mutation.Author(); ok {
+		_spec.SetField(nullablebook.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := nbuo.mutation.Genre(); ok {
+		_spec.SetField(nullablebook.FieldGenre, field.TypeString, value)
+	}
+	if value, ok := nbuo.mutation.Quantity(); ok {
+		_spec.SetField(nullablebook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := nbuo.mutation.AddedQuantity(); ok {
+		_spec.AddField(nullablebook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := nbuo.mutation.PublicizedAt(); ok {
+		_spec.SetField(nullablebook.FieldPublicizedAt, field.TypeTime, value)
+	}
+	if value, ok := nbuo.mutation.DiscontinuedAt(); ok {
+		_spec.SetField(nullablebook.FieldDiscontinuedAt, field.TypeTime, value)
+	}
+	if nbuo.mutation.DiscontinuedAtCleared() {
+		_spec.ClearField(nullablebook.FieldDiscontinuedAt, field.TypeTime)
+	}
+	_node = &NullableBook{config: nbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{nullablebook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nbuo.mutation.done = true
+	return _node, nil
+}
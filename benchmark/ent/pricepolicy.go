@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/book"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/pricepolicy"
+)
+
+// PricePolicy is the model entity for the PricePolicy schema.
+type PricePolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BookID holds the value of the "book_id" field.
+	BookID int `json:"book_id,omitempty"`
+	// Price holds the value of the "price" field.
+	Price float64 `json:"price,omitempty"`
+	// StartDate holds the value of the "start_date" field.
+	StartDate time.Time `json:"start_date,omitempty"`
+	// EndDate holds the value of the "end_date" field.
+	EndDate time.Time `json:"end_date,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PricePolicyQuery when eager-loading is set.
+	Edges        PricePolicyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PricePolicyEdges holds the relations/edges for other nodes in the graph.
+type PricePolicyEdges struct {
+	// Book holds the value of the book edge.
+	Book *Book `json:"book,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BookOrErr returns the Book value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PricePolicyEdges) BookOrErr() (*Book, error) {
+	if e.Book != nil {
+		return e.Book, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: book.Label}
+	}
+	return nil, &NotLoadedError{edge: "book"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PricePolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pricepolicy.FieldPrice:
+			values[i] = new(sql.NullFloat64)
+		case pricepolicy.FieldID, pricepolicy.FieldBookID:
+			values[i] = new(sql.NullInt64)
+		case pricepolicy.FieldStartDate, pricepolicy.FieldEndDate:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PricePolicy fields.
+func (pp *PricePolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pricepolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pp.ID = int(value.Int64)
+		case pricepolicy.FieldBookID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field book_id", values[i])
+			} else if value.Valid {
+				pp.BookID = int(value.Int64)
+			}
+		case pricepolicy.FieldPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				pp.Price = value.Float64
+			}
+		case pricepolicy.FieldStartDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_date", values[i])
+			} else if value.Valid {
+				pp.StartDate = value.Time
+			}
+		case pricepolicy.FieldEndDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_date", values[i])
+			} else if value.Valid {
+				pp.EndDate = value.Time
+			}
+		default:
+			pp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PricePolicy.
+// This includes values selected through modifiers, order, etc.
+func (pp *PricePolicy) Value(name string) (ent.Value, error) {
+	return pp.selectValues.Get(name)
+}
+
+// QueryBook queries the "book" edge of the PricePolicy entity.
+func (pp *PricePolicy) QueryBook() *BookQuery {
+	return NewPricePolicyClient(pp.config).QueryBook(pp)
+}
+
+// Update returns a builder for updating this PricePolicy.
+// Note that you need to call PricePolicy.Unwrap() before calling this method if this PricePolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pp *PricePolicy) Update() *PricePolicyUpdateOne {
+	return NewPricePolicyClient(pp.config).UpdateOne(pp)
+}
+
+// Unwrap unwraps the PricePolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pp *PricePolicy) Unwrap() *PricePolicy {
+	_tx, ok := pp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PricePolicy is not a transactional entity")
+	}
+	pp.config.driver = _tx.drv
+	return pp
+}
+
+// String implements the fmt.Stringer.
+func (pp *PricePolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("PricePolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pp.ID))
+	builder.WriteString("book_id=")
+	builder.WriteString(fmt.Sprintf("%v", pp.BookID))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", pp.Price))
+	builder.WriteString(", ")
+	builder.WriteString("start_date=")
+	builder.WriteString(pp.StartDate.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_date=")
+	builder.WriteString(pp.EndDate.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PricePolicies is a parsable slice of PricePolicy.
+type PricePolicies []*PricePolicy
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -24,5 +25,7 @@ func (Book) Fields() []ent.Field {
 
 // Edges of the Book.
 func (Book) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.To("policies", PricePolicy.Type),
+	}
 }
@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// JSONBook holds the schema definition for the JSONBook entity, the book of the schema variant
+// with a JSONB metadata column.
+type JSONBook struct {
+	ent.Schema
+}
+
+// Fields of the JSONBook.
+func (JSONBook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("isbn"),
+		field.String("title"),
+		field.String("author"),
+		field.String("genre"),
+		field.Int("quantity"),
+		field.Time("publicized_at"),
+		field.JSON("metadata", model.BookMetadata{}),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// PricePolicy holds the schema definition for the PricePolicy entity.
+type PricePolicy struct {
+	ent.Schema
+}
+
+// Fields of the PricePolicy.
+func (PricePolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("book_id").Optional(),
+		field.Float("price"),
+		field.Time("start_date"),
+		field.Time("end_date"),
+	}
+}
+
+// Edges of the PricePolicy.
+func (PricePolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("book", Book.Type).
+			Ref("policies").
+			Field("book_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+)
+
+// TaggedBook holds the schema definition for the TaggedBook entity, the book of the schema variant
+// with a TEXT[] tags column.
+type TaggedBook struct {
+	ent.Schema
+}
+
+// Fields of the TaggedBook.
+func (TaggedBook) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("isbn"),
+		field.String("title"),
+		field.String("author"),
+		field.String("genre"),
+		field.Int("quantity"),
+		field.Time("publicized_at"),
+		// field.Strings always encodes the slice as JSON, even with a text[] schema type, so the
+		// array goes through model.TextArray, which encodes it with the pgtype codecs.
+		field.Other("tags", model.TextArray{}).
+			SchemaType(map[string]string{dialect.Postgres: "text[]"}),
+	}
+}
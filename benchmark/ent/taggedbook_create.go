@@ -0,0 +1,794 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/taggedbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// TaggedBookCreate is the builder for creating a TaggedBook entity.
+type TaggedBookCreate struct {
+	config
+	mutation *TaggedBookMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetIsbn sets the "isbn" field.
+func (tbc *TaggedBookCreate) SetIsbn(s string) *TaggedBookCreate {
+	tbc.mutation.SetIsbn(s)
+	return tbc
+}
+
+// SetTitle sets the "title" field.
+func (tbc *TaggedBookCreate) SetTitle(s string) *TaggedBookCreate {
+	tbc.mutation.SetTitle(s)
+	return tbc
+}
+
+// SetAuthor sets the "author" field.
+func (tbc *TaggedBookCreate) SetAuthor(s string) *TaggedBookCreate {
+	tbc.mutation.SetAuthor(s)
+	return tbc
+}
+
+// SetGenre sets the "genre" field.
+func (tbc *TaggedBookCreate) SetGenre(s string) *TaggedBookCreate {
+	tbc.mutation.SetGenre(s)
+	return tbc
+}
+
+// SetQuantity sets the "quantity" field.
+func (tbc *TaggedBookCreate) SetQuantity(i int) *TaggedBookCreate {
+	tbc.mutation.SetQuantity(i)
+	return tbc
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (tbc *TaggedBookCreate) SetPublicizedAt(t time.Time) *TaggedBookCreate {
+	tbc.mutation.SetPublicizedAt(t)
+	return tbc
+}
+
+// SetTags sets the "tags" field.
+func (tbc *TaggedBookCreate) SetTags(ma model.TextArray) *TaggedBookCreate {
+	tbc.mutation.SetTags(ma)
+	return tbc
+}
+
+// Mutation returns the TaggedBookMutation object of the builder.
+func (tbc *TaggedBookCreate) Mutation() *TaggedBookMutation {
+	return tbc.mutation
+}
+
+// Save creates the TaggedBook in the database.
+func (tbc *TaggedBookCreate) Save(ctx context.Context) (*TaggedBook, error) {
+	return withHooks(ctx, tbc.sqlSave, tbc.mutation, tbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tbc *TaggedBookCreate) SaveX(ctx context.Context) *TaggedBook {
+	v, err := tbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tbc *TaggedBookCreate) Exec(ctx context.Context) error {
+	_, err := tbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbc *TaggedBookCreate) ExecX(ctx context.Context) {
+	if err := tbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tbc *TaggedBookCreate) check() error {
+	if _, ok := tbc.mutation.Isbn(); !ok {
+		return &ValidationError{Name: "isbn", err: errors.New(`ent: missing required field "TaggedBook.isbn"`)}
+	}
+	if _, ok := tbc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "TaggedBook.title"`)}
+	}
+	if _, ok := tbc.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "TaggedBook.author"`)}
+	}
+	if _, ok := tbc.mutation.Genre(); !ok {
+		return &ValidationError{Name: "genre", err: errors.New(`ent: missing required field "TaggedBook.genre"`)}
+	}
+	if _, ok := tbc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "TaggedBook.quantity"`)}
+	}
+	if _, ok := tbc.mutation.PublicizedAt(); !ok {
+		return &ValidationError{Name: "publicized_at", err: errors.New(`ent: missing required field "TaggedBook.publicized_at"`)}
+	}
+	if _, ok := tbc.mutation.Tags(); !ok {
+		return &ValidationError{Name: "tags", err: errors.New(`ent: missing required field "TaggedBook.tags"`)}
+	}
+	return nil
+}
+
+func (tbc *TaggedBookCreate) sqlSave(ctx context.Context) (*TaggedBook, error) {
+	if err := tbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tbc.mutation.id = &_node.ID
+	tbc.mutation.done = true
+	return _node, nil
+}
+
+func (tbc *TaggedBookCreate) createSpec() (*TaggedBook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaggedBook{config: tbc.config}
+		_spec = sqlgraph.NewCreateSpec(taggedbook.Table, sqlgraph.NewFieldSpec(taggedbook.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = tbc.conflict
+	if value, ok := tbc.mutation.Isbn(); ok {
+		_spec.SetField(taggedbook.FieldIsbn, field.TypeString, value)
+		_node.Isbn = value
+	}
+	if value, ok := tbc.mutation.Title(); ok {
+		_spec.SetField(taggedbook.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := tbc.mutation.Author(); ok {
+		_spec.SetField(taggedbook.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := tbc.mutation.Genre(); ok {
+		_spec.SetField(taggedbook.FieldGenre, field.TypeString, value)
+		_node.Genre = value
+	}
+	if value, ok := tbc.mutation.Quantity(); ok {
+		_spec.SetField(taggedbook.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := tbc.mutation.PublicizedAt(); ok {
+		_spec.SetField(taggedbook.FieldPublicizedAt, field.TypeTime, value)
+		_node.PublicizedAt = value
+	}
+	if value, ok := tbc.mutation.Tags(); ok {
+		_spec.SetField(taggedbook.FieldTags, field.TypeOther, value)
+		_node.Tags = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaggedBook.Create().
+//		SetIsbn(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaggedBookUpsert) {
+//			SetIsbn(v+v).
+//		}).
+//		Exec(ctx)
+func (tbc *TaggedBookCreate) OnConflict(opts ...sql.ConflictOption) *TaggedBookUpsertOne {
+	tbc.conflict = opts
+	return &TaggedBookUpsertOne{
+		create: tbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaggedBook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tbc *TaggedBookCreate) OnConflictColumns(columns ...string) *TaggedBookUpsertOne {
+	tbc.conflict = append(tbc.conflict, sql.ConflictColumns(columns...))
+	return &TaggedBookUpsertOne{
+		create: tbc,
+	}
+}
+
+type (
+	// TaggedBookUpsertOne is the builder for "upsert"-ing
+	//  one TaggedBook node.
+	TaggedBookUpsertOne struct {
+		create *TaggedBookCreate
+	}
+
+	// TaggedBookUpsert is the "OnConflict" setter.
+	TaggedBookUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetIsbn sets the "isbn" field.
+func (u *TaggedBookUpsert) SetIsbn(v string) *TaggedBookUpsert {
+	u.Set(taggedbook.FieldIsbn, v)
+	return u
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *TaggedBookUpsert) UpdateIsbn() *TaggedBookUpsert {
+	u.SetExcluded(taggedbook.FieldIsbn)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *TaggedBookUpsert) SetTitle(v string) *TaggedBookUpsert {
+	u.Set(taggedbook.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TaggedBookUpsert) UpdateTitle() *TaggedBookUpsert {
+	u.SetExcluded(taggedbook.FieldTitle)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *TaggedBookUpsert) SetAuthor(v string) *TaggedBookUpsert {
+	u.Set(taggedbook.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *TaggedBookUpsert) UpdateAuthor() *TaggedBookUpsert {
+	u.SetExcluded(taggedbook.FieldAuthor)
+	return u
+}
+
+// SetGenre sets the "genre" field.
+func (u *TaggedBookUpsert) SetGenre(v string) *TaggedBookUpsert {
+	u.Set(taggedbook.FieldGenre, v)
+	return u
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *TaggedBookUpsert) UpdateGenre() *TaggedBookUpsert {
+	u.SetExcluded(taggedbook.FieldGenre)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *TaggedBookUpsert) SetQuantity(v int) *TaggedBookUpsert {
+	u.Set(taggedbook.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *TaggedBookUpsert) UpdateQuantity() *TaggedBookUpsert {
+	u.SetExcluded(taggedbook.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *TaggedBookUpsert) AddQuantity(v int) *TaggedBookUpsert {
+	u.Add(taggedbook.FieldQuantity, v)
+	return u
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *TaggedBookUpsert) SetPublicizedAt(v time.Time) *TaggedBookUpsert {
+	u.Set(taggedbook.FieldPublicizedAt, v)
+	return u
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *TaggedBookUpsert) UpdatePublicizedAt() *TaggedBookUpsert {
+	u.SetExcluded(taggedbook.FieldPublicizedAt)
+	return u
+}
+
+// SetTags sets the "tags" field.
+func (u *TaggedBookUpsert) SetTags(v model.TextArray) *TaggedBookUpsert {
+	u.Set(taggedbook.FieldTags, v)
+	return u
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *TaggedBookUpsert) UpdateTags() *TaggedBookUpsert {
+	u.SetExcluded(taggedbook.FieldTags)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.TaggedBook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaggedBookUpsertOne) UpdateNewValues() *TaggedBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaggedBook.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaggedBookUpsertOne) Ignore() *TaggedBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaggedBookUpsertOne) DoNothing() *TaggedBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaggedBookCreate.OnConflict
+// documentation for more info.
+func (u *TaggedBookUpsertOne) Update(set func(*TaggedBookUpsert)) *TaggedBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaggedBookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetIsbn sets the "isbn" field.
+func (u *TaggedBookUpsertOne) SetIsbn(v string) *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetIsbn(v)
+	})
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *TaggedBookUpsertOne) UpdateIsbn() *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateIsbn()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *TaggedBookUpsertOne) SetTitle(v string) *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TaggedBookUpsertOne) UpdateTitle() *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *TaggedBookUpsertOne) SetAuthor(v string) *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *TaggedBookUpsertOne) UpdateAuthor() *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetGenre sets the "genre" field.
+func (u *TaggedBookUpsertOne) SetGenre(v string) *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetGenre(v)
+	})
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *TaggedBookUpsertOne) UpdateGenre() *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateGenre()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *TaggedBookUpsertOne) SetQuantity(v int) *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *TaggedBookUpsertOne) AddQuantity(v int) *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *TaggedBookUpsertOne) UpdateQuantity() *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *TaggedBookUpsertOne) SetPublicizedAt(v time.Time) *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetPublicizedAt(v)
+	})
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *TaggedBookUpsertOne) UpdatePublicizedAt() *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdatePublicizedAt()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *TaggedBookUpsertOne) SetTags(v model.TextArray) *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *TaggedBookUpsertOne) UpdateTags() *TaggedBookUpsertOne {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// Exec executes the query.
+func (u *TaggedBookUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaggedBookCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaggedBookUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaggedBookUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaggedBookUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaggedBookCreateBulk is the builder for creating many TaggedBook entities in bulk.
+type TaggedBookCreateBulk struct {
+	config
+	err      error
+	builders []*TaggedBookCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaggedBook entities in the database.
+func (tbcb *TaggedBookCreateBulk) Save(ctx context.Context) ([]*TaggedBook, error) {
+	if tbcb.err != nil {
+		return nil, tbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tbcb.builders))
+	nodes := make([]*TaggedBook, len(tbcb.builders))
+	mutators := make([]Mutator, len(tbcb.builders))
+	for i := range tbcb.builders {
+		func(i int, root context.Context) {
+			builder := tbcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaggedBookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tbcb *TaggedBookCreateBulk) SaveX(ctx context.Context) []*TaggedBook {
+	v, err := tbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tbcb *TaggedBookCreateBulk) Exec(ctx context.Context) error {
+	_, err := tbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbcb *TaggedBookCreateBulk) ExecX(ctx context.Context) {
+	if err := tbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaggedBook.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaggedBookUpsert) {
+//			SetIsbn(v+v).
+//		}).
+//		Exec(ctx)
+func (tbcb *TaggedBookCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaggedBookUpsertBulk {
+	tbcb.conflict = opts
+	return &TaggedBookUpsertBulk{
+		create: tbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaggedBook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tbcb *TaggedBookCreateBulk) OnConflictColumns(columns ...string) *TaggedBookUpsertBulk {
+	tbcb.conflict = append(tbcb.conflict, sql.ConflictColumns(columns...))
+	return &TaggedBookUpsertBulk{
+		create: tbcb,
+	}
+}
+
+// TaggedBookUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaggedBook nodes.
+type TaggedBookUpsertBulk struct {
+	create *TaggedBookCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaggedBook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *TaggedBookUpsertBulk) UpdateNewValues() *TaggedBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaggedBook.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaggedBookUpsertBulk) Ignore() *TaggedBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaggedBookUpsertBulk) DoNothing() *TaggedBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaggedBookCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaggedBookUpsertBulk) Update(set func(*TaggedBookUpsert)) *TaggedBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaggedBookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetIsbn sets the "isbn" field.
+func (u *TaggedBookUpsertBulk) SetIsbn(v string) *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetIsbn(v)
+	})
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *TaggedBookUpsertBulk) UpdateIsbn() *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateIsbn()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *TaggedBookUpsertBulk) SetTitle(v string) *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *TaggedBookUpsertBulk) UpdateTitle() *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *TaggedBookUpsertBulk) SetAuthor(v string) *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *TaggedBookUpsertBulk) UpdateAuthor() *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetGenre sets the "genre" field.
+func (u *TaggedBookUpsertBulk) SetGenre(v string) *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetGenre(v)
+	})
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *TaggedBookUpsertBulk) UpdateGenre() *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateGenre()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *TaggedBookUpsertBulk) SetQuantity(v int) *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *TaggedBookUpsertBulk) AddQuantity(v int) *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *TaggedBookUpsertBulk) UpdateQuantity() *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *TaggedBookUpsertBulk) SetPublicizedAt(v time.Time) *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetPublicizedAt(v)
+	})
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *TaggedBookUpsertBulk) UpdatePublicizedAt() *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdatePublicizedAt()
+	})
+}
+
+// SetTags sets the "tags" field.
+func (u *TaggedBookUpsertBulk) SetTags(v model.TextArray) *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.SetTags(v)
+	})
+}
+
+// UpdateTags sets the "tags" field to the value that was provided on create.
+func (u *TaggedBookUpsertBulk) UpdateTags() *TaggedBookUpsertBulk {
+	return u.Update(func(s *TaggedBookUpsert) {
+		s.UpdateTags()
+	})
+}
+
+// Exec executes the query.
+func (u *TaggedBookUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaggedBookCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaggedBookCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaggedBookUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
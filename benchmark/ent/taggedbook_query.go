@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/predicate"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent/taggedbook"
+)
+
+// TaggedBookQuery is the builder for querying TaggedBook entities.
+type TaggedBookQuery struct {
+	config
+	ctx        *QueryContext
+	order      []taggedbook.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TaggedBook
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TaggedBookQuery builder.
+func (tbq *TaggedBookQuery) Where(ps ...predicate.TaggedBook) *TaggedBookQuery {
+	tbq.predicates = append(tbq.predicates, ps...)
+	return tbq
+}
+
+// Limit the number of records to be returned by this query.
+func (tbq *TaggedBookQuery) Limit(limit int) *TaggedBookQuery {
+	tbq.ctx.Limit = &limit
+	return tbq
+}
+
+// Offset to start from.
+func (tbq *TaggedBookQuery) Offset(offset int) *TaggedBookQuery {
+	tbq.ctx.Offset = &offset
+	return tbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tbq *TaggedBookQuery) Unique(unique bool) *TaggedBookQuery {
+	tbq.ctx.Unique = &unique
+	return tbq
+}
+
+// Order specifies how the records should be ordered.
+func (tbq *TaggedBookQuery) Order(o ...taggedbook.OrderOption) *TaggedBookQuery {
+	tbq.order = append(tbq.order, o...)
+	return tbq
+}
+
+// First returns the first TaggedBook entity from the query.
+// Returns a *NotFoundError when no TaggedBook was found.
+func (tbq *TaggedBookQuery) First(ctx context.Context) (*TaggedBook, error) {
+	nodes, err := tbq.Limit(1).All(setContextOp(ctx, tbq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{taggedbook.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tbq *TaggedBookQuery) FirstX(ctx context.Context) *TaggedBook {
+	node, err := tbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TaggedBook ID from the query.
+// Returns a *NotFoundError when no TaggedBook ID was found.
+func (tbq *TaggedBookQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tbq.Limit(1).IDs(setContextOp(ctx, tbq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{taggedbook.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tbq *TaggedBookQuery) FirstIDX(ctx context.Context) int {
+	id, err := tbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TaggedBook entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TaggedBook entity is found.
+// Returns a *NotFoundError when no TaggedBook entities are found.
+func (tbq *TaggedBookQuery) Only(ctx context.Context) (*TaggedBook, error) {
+	nodes, err := tbq.Limit(2).All(setContextOp(ctx, tbq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{taggedbook.Label}
+	default:
+		return nil, &NotSingularError{taggedbook.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tbq *TaggedBookQuery) OnlyX(ctx context.Context) *TaggedBook {
+	node, err := tbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TaggedBook ID in the query.
+// Returns a *NotSingularError when more than one TaggedBook ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tbq *TaggedBookQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tbq.Limit(2).IDs(setContextOp(ctx, tbq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{taggedbook.Label}
+	default:
+		err = &NotSingularError{taggedbook.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tbq *TaggedBookQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TaggedBooks.
+func (tbq *TaggedBookQuery) All(ctx context.Context) ([]*TaggedBook, error) {
+	ctx = setContextOp(ctx, tbq.ctx, "All")
+	if err := tbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TaggedBook, *TaggedBookQuery]()
+	return withInterceptors[[]*TaggedBook](ctx, tbq, qr, tbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tbq *TaggedBookQuery) AllX(ctx context.Context) []*TaggedBook {
+	nodes, err := tbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TaggedBook IDs.
+func (tbq *TaggedBookQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tbq.ctx.Unique == nil && tbq.path != nil {
+		tbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tbq.ctx, "IDs")
+	if err = tbq.Select(taggedbook.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tbq *TaggedBookQuery) IDsX(ctx context.Context) []int {
+	ids, err := tbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tbq *TaggedBookQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tbq.ctx, "Count")
+	if err := tbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tbq, querierCount[*TaggedBookQuery](), tbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tbq *TaggedBookQuery) CountX(ctx context.Context) int {
+	count, err := tbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tbq *TaggedBookQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tbq.ctx, "Exist")
+	switch _, err := tbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tbq *TaggedBookQuery) ExistX(ctx context.Context) bool {
+	exist, err := tbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TaggedBookQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tbq *TaggedBookQuery) Clone() *TaggedBookQuery {
+	if tbq == nil {
+		return nil
+	}
+	return &TaggedBookQuery{
+		config:     tbq.config,
+		ctx:        tbq.ctx.Clone(),
+		order:      append([]taggedbook.OrderOption{}, tbq.order...),
+		inters:     append([]Interceptor{}, tbq.inters...),
+		predicates: append([]predicate.TaggedBook{}, tbq.predicates...),
+		// clone intermediate query.
+		sql:  tbq.sql.Clone(),
+		path: tbq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Isbn string `json:"isbn,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TaggedBook.Query().
+//		GroupBy(taggedbook.FieldIsbn).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tbq *TaggedBookQuery) GroupBy(field string, fields ...string) *TaggedBookGroupBy {
+	tbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TaggedBookGroupBy{build: tbq}
+	grbuild.flds = &tbq.ctx.Fields
+	grbuild.label = taggedbook.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Isbn string `json:"isbn,omitempty"`
+//	}
+//
+//	client.TaggedBook.Query().
+//		Select(taggedbook.FieldIsbn).
+//		Scan(ctx, &v)
+func (tbq *TaggedBookQuery) Select(fields ...string) *TaggedBookSelect {
+	tbq.ctx.Fields = append(tbq.ctx.Fields, fields...)
+	sbuild := &TaggedBookSelect{TaggedBookQuery: tbq}
+	sbuild.label = taggedbook.Label
+	sbuild.flds, sbuild.scan = &tbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TaggedBookSelect configured with the given aggregations.
+func (tbq *TaggedBookQuery) Aggregate(fns ...AggregateFunc) *TaggedBookSelect {
+	return tbq.Select().Aggregate(fns...)
+}
+
+func (tbq *TaggedBookQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tbq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tbq.ctx.Fields {
+		if !taggedbook.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tbq.path != nil {
+		prev, err := tbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tbq.sql = prev
+	}
+	return nil
+}
+
+func (tbq *TaggedBookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TaggedBook, error) {
+	var (
+		nodes = []*TaggedBook{}
+		_spec = tbq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TaggedBook).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TaggedBook{config: tbq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tbq *TaggedBookQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tbq.querySpec()
+	_spec.Node.Columns = tbq.ctx.Fields
+	if len(tbq.ctx.Fields) > 0 {
+		_spec.Unique = tbq.ctx.Unique != nil && *tbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tbq.driver, _spec)
+}
+
+func (tbq *TaggedBookQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(taggedbook.Table, taggedbook.Columns, sqlgraph.NewFieldSpec(taggedbook.FieldID, field.TypeInt))
+	_spec.From = tbq.sql
+	if unique := tbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taggedbook.FieldID)
+		for i := range fields {
+			if fields[i] != taggedbook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tbq *TaggedBookQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tbq.driver.Dialect())
+	t1 := builder.Table(taggedbook.Table)
+	columns := tbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = taggedbook.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tbq.sql != nil {
+		selector = tbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tbq.ctx.Unique != nil && *tbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tbq.predicates {
+		p(selector)
+	}
+	for _, p := range tbq.order {
+		p(selector)
+	}
+	if offset := tbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TaggedBookGroupBy is the group-by builder for TaggedBook entities.
+type TaggedBookGroupBy struct {
+	selector
+	build *TaggedBookQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tbgb *TaggedBookGroupBy) Aggregate(fns ...AggregateFunc) *TaggedBookGroupBy {
+	tbgb.fns = append(tbgb.fns, fns...)
+	return tbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tbgb *TaggedBookGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tbgb.build.ctx, "GroupBy")
+	if err := tbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaggedBookQuery, *TaggedBookGroupBy](ctx, tbgb.build, tbgb, tbgb.build.inters, v)
+}
+
+func (tbgb *TaggedBookGroupBy) sqlScan(ctx context.Context, root *TaggedBookQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tbgb.fns))
+	for _, fn := range tbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tbgb.flds)+len(tbgb.fns))
+		for _, f := range *tbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TaggedBookSelect is the builder for selecting fields of TaggedBook entities.
+type TaggedBookSelect struct {
+	*TaggedBookQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tbs *TaggedBookSelect) Aggregate(fns ...AggregateFunc) *TaggedBookSelect {
+	tbs.fns = append(tbs.fns, fns...)
+	return tbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tbs *TaggedBookSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tbs.ctx, "Select")
+	if err := tbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TaggedBookQuery, *TaggedBookSelect](ctx, tbs.TaggedBookQuery, tbs, tbs.inters, v)
+}
+
+func (tbs *TaggedBookSelect) sqlScan(ctx context.Context, root *TaggedBookQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tbs.fns))
+	for _, fn := range tbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
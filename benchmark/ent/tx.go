@@ -14,6 +14,14 @@ type Tx struct {
 	config
 	// Book is the client for interacting with the Book builders.
 	Book *BookClient
+	// JSONBook is the client for interacting with the JSONBook builders.
+	JSONBook *JSONBookClient
+	// NullableBook is the client for interacting with the NullableBook builders.
+	NullableBook *NullableBookClient
+	// PricePolicy is the client for interacting with the PricePolicy builders.
+	PricePolicy *PricePolicyClient
+	// TaggedBook is the client for interacting with the TaggedBook builders.
+	TaggedBook *TaggedBookClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +154,10 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Book = NewBookClient(tx.config)
+	tx.JSONBook = NewJSONBookClient(tx.config)
+	tx.NullableBook = NewNullableBookClient(tx.config)
+	tx.PricePolicy = NewPricePolicyClient(tx.config)
+	tx.TaggedBook = NewTaggedBookClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,451 @@
+package benchmark
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/ent"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/book"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/jsonbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/nullablebook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/predicate"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/pricepolicy"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/taggedbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// EntUUIDBenchmark runs the operations of EntBenchmark on the UUIDv7 key variant, through the
+// client Ent generates into entuuid. The bulk updates and deletes and the aggregation, which never
+// read a key, are those of EntBenchmark, and the builders are filled by the same helpers, see setBook.
+type EntUUIDBenchmark struct {
+	EntBenchmark
+	uuidDB *entuuid.Client
+}
+
+// Init opens both clients on the same driver, so that they share a pool.
+func (o *EntUUIDBenchmark) Init() error {
+	db, err := sql.Open("pgx", utils.PostgresDSN)
+	if err != nil {
+		return err
+	}
+	utils.ConfigurePool(db)
+	drv := entsql.OpenDB(dialect.Postgres, db)
+	o.db = ent.NewClient(ent.Driver(drv))
+	o.uuidDB = entuuid.NewClient(entuuid.Driver(drv))
+	return nil
+}
+
+func (o *EntUUIDBenchmark) Insert(b *testing.B) {
+	newBook := model.NewBook[uuid.UUID]()
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				_, err := setBook(o.uuidDB.Book.Create(), newBook).Save(o.ctx)
+				return err
+			},
+		}
+	})
+}
+
+func (o *EntUUIDBenchmark) InsertBulk(b *testing.B) {
+	books := model.NewBooks[uuid.UUID](utils.BulkInsertNumber)
+
+	run(b, func() step {
+		batch := make([]*entuuid.BookCreate, len(books))
+		for i, newBook := range books {
+			batch[i] = setBook(o.uuidDB.Book.Create(), newBook)
+		}
+		return step{
+			exec: func(int) error {
+				return inStatements(batch, func(chunk []*entuuid.BookCreate) error {
+					_, err := o.uuidDB.Book.CreateBulk(chunk...).Save(o.ctx)
+					return err
+				})
+			},
+		}
+	})
+}
+
+func (o *EntUUIDBenchmark) Update(b *testing.B) {
+	run(b, func() step {
+		newBook := model.NewBook[uuid.UUID]()
+		saved, err := setBook(o.uuidDB.Book.Create(), newBook).Save(o.ctx)
+		if err != nil {
+			b.Error(err)
+			return step{exec: func(int) error { return err }}
+		}
+		return step{
+			exec: func(int) error {
+				_, err := setBook(o.uuidDB.Book.UpdateOneID(saved.ID), newBook).Save(o.ctx)
+				return err
+			},
+		}
+	})
+}
+
+// Delete seeds the books with COPY, see EntBenchmark.Delete.
+func (o *EntUUIDBenchmark) Delete(b *testing.B) {
+	keys, err := seedKeyedBooks[uuid.UUID](b.N)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				return o.uuidDB.Book.
+					DeleteOneID(keys[i]).
+					Exec(o.ctx)
+			},
+		}
+	})
+}
+
+// FindByID seeds the books with COPY, see Delete.
+func (o *EntUUIDBenchmark) FindByID(b *testing.B) {
+	keys, err := seedKeyedBooks[uuid.UUID](b.N)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := o.uuidDB.Book.Get(o.ctx, keys[i])
+				return err
+			},
+		}
+	})
+}
+
+// FindPage seeds the books with COPY, see Delete.
+func (o *EntUUIDBenchmark) FindPage(b *testing.B) {
+	keys, err := seedKeyedBooks[uuid.UUID](b.N)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := o.uuidDB.Book.
+					Query().
+					Where(book.IDGT(cursorAt(keys, i))).
+					Order(entuuid.Asc(book.FieldID)).
+					Limit(utils.PageSize).
+					All(o.ctx)
+				return err
+			},
+		}
+	})
+}
+
+func (o *EntUUIDBenchmark) FindWithActivePolicy(b *testing.B) {
+	now := time.Now().UTC()
+	keys, err := seedPricedBooks[uuid.UUID](b.N, now)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	active := func(q *entuuid.PricePolicyQuery) {
+		q.Where(pricepolicy.StartDateLTE(now), pricepolicy.EndDateGT(now))
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := o.uuidDB.Book.
+					Query().
+					Where(
+						book.IDGT(cursorAt(keys, i)),
+						book.HasPoliciesWith(pricepolicy.StartDateLTE(now), pricepolicy.EndDateGT(now)),
+					).
+					WithPolicies(active).
+					Order(entuuid.Asc(book.FieldID)).
+					Limit(utils.PageSize).
+					All(o.ctx)
+				return err
+			},
+		}
+	})
+}
+
+// FindPageWithPolicies has no join strategy, see EntBenchmark.FindPageWithPolicies.
+func (o *EntUUIDBenchmark) FindPageWithPolicies(strategy LoadStrategy) func(b *testing.B) {
+	switch strategy {
+	case NPlusOne:
+		return eagerBenchmark(func(cursor uuid.UUID) error {
+			books, err := o.uuidDB.Book.Query().Where(book.IDGT(cursor)).Order(entuuid.Asc(book.FieldID)).Limit(utils.PageSize).All(o.ctx)
+			if err != nil {
+				return err
+			}
+			for _, found := range books {
+				found.Edges.Policies, err = found.QueryPolicies().Order(entuuid.Asc(pricepolicy.FieldID)).All(o.ctx)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	case Preload:
+		return eagerBenchmark(func(cursor uuid.UUID) error {
+			_, err := o.uuidDB.Book.Query().
+				Where(book.IDGT(cursor)).
+				WithPolicies().
+				Order(entuuid.Asc(book.FieldID)).
+				Limit(utils.PageSize).
+				All(o.ctx)
+			return err
+		})
+	}
+	return nil
+}
+
+func (o *EntUUIDBenchmark) Transaction(b *testing.B) {
+	o.transaction(b, false)
+}
+
+func (o *EntUUIDBenchmark) TransactionRollback(b *testing.B) {
+	o.transaction(b, true)
+}
+
+func (o *EntUUIDBenchmark) transaction(b *testing.B, rollback bool) {
+	now := time.Now().UTC()
+	newBook := model.NewBook[uuid.UUID]()
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				tx, err := o.uuidDB.Tx(o.ctx)
+				if err != nil {
+					return err
+				}
+
+				saved, err := setBook(tx.Book.Create(), newBook).Save(o.ctx)
+				if err != nil {
+					_ = tx.Rollback()
+					return err
+				}
+
+				policies := model.NewPricePolicies(saved.ID, now)
+				batch := make([]*entuuid.PricePolicyCreate, len(policies))
+				for i, policy := range policies {
+					batch[i] = tx.PricePolicy.Create().
+						SetBookID(saved.ID).
+						SetPrice(policy.Price).
+						SetStartDate(policy.StartDate).
+						SetEndDate(policy.EndDate)
+				}
+				if _, err = tx.PricePolicy.CreateBulk(batch...).Save(o.ctx); err != nil {
+					_ = tx.Rollback()
+					return err
+				}
+
+				if rollback {
+					return tx.Rollback()
+				}
+				return tx.Commit()
+			},
+		}
+	})
+}
+
+func (o *EntUUIDBenchmark) Upsert(b *testing.B) {
+	upsertBenchmark(b, func(books []*model.Book[uuid.UUID]) error {
+		batch := make([]*entuuid.BookCreate, len(books))
+		for i, newBook := range books {
+			batch[i] = setBook(o.uuidDB.Book.Create(), newBook)
+		}
+		return inStatements(batch, func(chunk []*entuuid.BookCreate) error {
+			return o.uuidDB.Book.CreateBulk(chunk...).
+				OnConflictColumns(book.FieldIsbn).
+				UpdateQuantity().
+				Exec(o.ctx)
+		})
+	})
+}
+
+// FindByIDs builds the AnyArray predicate by hand, see EntBenchmark.FindByIDs.
+func (o *EntUUIDBenchmark) FindByIDs(list IDList) func(b *testing.B) {
+	switch list {
+	case InList:
+		return manyIDsBenchmark(func(ids []uuid.UUID) error {
+			_, err := o.uuidDB.Book.Query().Where(book.IDIn(ids...)).All(o.ctx)
+			return err
+		})
+	case AnyArray:
+		return manyIDsBenchmark(func(ids []uuid.UUID) error {
+			_, err := o.uuidDB.Book.Query().Where(func(s *entsql.Selector) {
+				s.Where(entsql.P(func(b *entsql.Builder) {
+					b.Ident(s.C(book.FieldID)).WriteString(" = ANY(").Arg(ids).WriteString(")")
+				}))
+			}).All(o.ctx)
+			return err
+		})
+	}
+	return nil
+}
+
+func (o *EntUUIDBenchmark) Search(b *testing.B) {
+	searchBenchmark(b, func(f searchFilter) error {
+		var predicates []predicate.Book
+		if f.AuthorPrefix != nil {
+			predicates = append(predicates, book.AuthorHasPrefix(*f.AuthorPrefix))
+		}
+		if f.Genre != nil {
+			predicates = append(predicates, book.GenreEQ(*f.Genre))
+		}
+		if f.PublishedAfter != nil {
+			predicates = append(predicates, book.PublicizedAtGTE(*f.PublishedAfter))
+		}
+		if f.PublishedBefore != nil {
+			predicates = append(predicates, book.PublicizedAtLT(*f.PublishedBefore))
+		}
+		if f.MinQuantity != nil {
+			predicates = append(predicates, book.QuantityGTE(*f.MinQuantity))
+		}
+		if f.MaxQuantity != nil {
+			predicates = append(predicates, book.QuantityLTE(*f.MaxQuantity))
+		}
+		order := entuuid.Asc(f.SortBy)
+		if f.Descending {
+			order = entuuid.Desc(f.SortBy)
+		}
+
+		_, err := o.uuidDB.Book.Query().
+			Where(predicates...).
+			Order(order, entuuid.Asc(book.FieldID)).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
+}
+
+func (o *EntUUIDBenchmark) FindPageAt(pagination Pagination, depth int) func(b *testing.B) {
+	return paginationBenchmark(depth, func(depth int, cursor uuid.UUID) error {
+		query := o.uuidDB.Book.Query().Order(entuuid.Asc(book.FieldID)).Limit(utils.PageSize)
+		if pagination == Offset {
+			query = query.Offset(depth)
+		} else {
+			query = query.Where(book.IDGT(cursor))
+		}
+		_, err := query.All(o.ctx)
+		return err
+	})
+}
+
+func (o *EntUUIDBenchmark) FindTitles(b *testing.B) {
+	projectionBenchmark(b, func(cursor uuid.UUID) error {
+		var titles []bookTitle[uuid.UUID]
+		return o.uuidDB.Book.Query().
+			Where(book.IDGT(cursor)).
+			Order(entuuid.Asc(book.FieldID)).
+			Limit(utils.PageSize).
+			Select(book.FieldID, book.FieldTitle).
+			Scan(o.ctx, &titles)
+	})
+}
+
+// ScanLarge has no streaming mode, see EntBenchmark.ScanLarge.
+func (o *EntUUIDBenchmark) ScanLarge(mode ScanMode) func(b *testing.B) {
+	if mode != Buffered {
+		return nil
+	}
+	return scanLargeBenchmark(func() error {
+		_, err := o.uuidDB.Book.Query().All(o.ctx)
+		return err
+	})
+}
+
+func (o *EntUUIDBenchmark) InsertNullable(b *testing.B) {
+	insertNullableBenchmark(b, func(book *model.NullableBook[uuid.UUID]) error {
+		_, err := setNullableBook(o.uuidDB.NullableBook.Create(), book).Save(o.ctx)
+		return err
+	})
+}
+
+func (o *EntUUIDBenchmark) FindNullable(b *testing.B) {
+	findNullableBenchmark(b, func(cursor uuid.UUID) error {
+		_, err := o.uuidDB.NullableBook.
+			Query().
+			Where(nullablebook.IDGT(cursor)).
+			Order(entuuid.Asc(nullablebook.FieldID)).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
+}
+
+func (o *EntUUIDBenchmark) UpdateNullable(b *testing.B) {
+	updateNullableBenchmark(b, func(book *model.NullableBook[uuid.UUID]) error {
+		_, err := updateNullableBook(o.uuidDB.NullableBook.UpdateOneID(book.ID), book).Save(o.ctx)
+		return err
+	})
+}
+
+func (o *EntUUIDBenchmark) InsertJSON(size DocumentSize) func(b *testing.B) {
+	return insertJSONBenchmark(size, func(book *model.JSONBook[uuid.UUID]) error {
+		_, err := setJSONBook(o.uuidDB.JSONBook.Create(), book).Save(o.ctx)
+		return err
+	})
+}
+
+func (o *EntUUIDBenchmark) FindJSON(size DocumentSize) func(b *testing.B) {
+	return findJSONBenchmark(size, func(cursor uuid.UUID) error {
+		_, err := o.uuidDB.JSONBook.
+			Query().
+			Where(jsonbook.IDGT(cursor)).
+			Order(entuuid.Asc(jsonbook.FieldID)).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
+}
+
+func (o *EntUUIDBenchmark) InsertTags(b *testing.B) {
+	insertTagsBenchmark(b, func(book *model.TaggedBook[uuid.UUID]) error {
+		_, err := setTaggedBook(o.uuidDB.TaggedBook.Create(), book).Save(o.ctx)
+		return err
+	})
+}
+
+func (o *EntUUIDBenchmark) FindTags(b *testing.B) {
+	findTagsBenchmark(b, func(cursor uuid.UUID) error {
+		_, err := o.uuidDB.TaggedBook.
+			Query().
+			Where(taggedbook.IDGT(cursor)).
+			Order(entuuid.Asc(taggedbook.FieldID)).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
+}
+
+// FilterTags builds the @> predicate by hand, see EntBenchmark.FilterTags.
+func (o *EntUUIDBenchmark) FilterTags(b *testing.B) {
+	filterTagsBenchmark[uuid.UUID](b, func(tags []string) error {
+		_, err := o.uuidDB.TaggedBook.
+			Query().
+			Where(func(s *entsql.Selector) {
+				s.Where(entsql.P(func(b *entsql.Builder) {
+					b.Ident(s.C(taggedbook.FieldTags)).WriteString(" @> ").Arg(model.TextArray(tags))
+				}))
+			}).
+			Order(entuuid.Asc(taggedbook.FieldID)).
+			Limit(utils.PageSize).
+			All(o.ctx)
+		return err
+	})
+}
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/book"
+	"github.com/google/uuid"
+)
+
+// Book is the model entity for the Book schema.
+type Book struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Isbn holds the value of the "isbn" field.
+	Isbn string `json:"isbn,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// Genre holds the value of the "genre" field.
+	Genre string `json:"genre,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// PublicizedAt holds the value of the "publicized_at" field.
+	PublicizedAt time.Time `json:"publicized_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookQuery when eager-loading is set.
+	Edges        BookEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BookEdges holds the relations/edges for other nodes in the graph.
+type BookEdges struct {
+	// Policies holds the value of the policies edge.
+	Policies []*PricePolicy `json:"policies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PoliciesOrErr returns the Policies value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) PoliciesOrErr() ([]*PricePolicy, error) {
+	if e.loadedTypes[0] {
+		return e.Policies, nil
+	}
+	return nil, &NotLoadedError{edge: "policies"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Book) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case book.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case book.FieldIsbn, book.FieldTitle, book.FieldAuthor, book.FieldGenre:
+			values[i] = new(sql.NullString)
+		case book.FieldPublicizedAt:
+			values[i] = new(sql.NullTime)
+		case book.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Book fields.
+func (b *Book) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case book.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				b.ID = *value
+			}
+		case book.FieldIsbn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field isbn", values[i])
+			} else if value.Valid {
+				b.Isbn = value.String
+			}
+		case book.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				b.Title = value.String
+			}
+		case book.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				b.Author = value.String
+			}
+		case book.FieldGenre:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field genre", values[i])
+			} else if value.Valid {
+				b.Genre = value.String
+			}
+		case book.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				b.Quantity = int(value.Int64)
+			}
+		case book.FieldPublicizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field publicized_at", values[i])
+			} else if value.Valid {
+				b.PublicizedAt = value.Time
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Book.
+// This includes values selected through modifiers, order, etc.
+func (b *Book) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QueryPolicies queries the "policies" edge of the Book entity.
+func (b *Book) QueryPolicies() *PricePolicyQuery {
+	return NewBookClient(b.config).QueryPolicies(b)
+}
+
+// Update returns a builder for updating this Book.
+// Note that you need to call Book.Unwrap() before calling this method if this Book
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Book) Update() *BookUpdateOne {
+	return NewBookClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Book entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Book) Unwrap() *Book {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("entuuid: Book is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Book) String() string {
+	var builder strings.Builder
+	builder.WriteString("Book(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("isbn=")
+	builder.WriteString(b.Isbn)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(b.Title)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(b.Author)
+	builder.WriteString(", ")
+	builder.WriteString("genre=")
+	builder.WriteString(b.Genre)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", b.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("publicized_at=")
+	builder.WriteString(b.PublicizedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Books is a parsable slice of Book.
+type Books []*Book
@@ -0,0 +1,946 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/migrate"
+	"github.com/google/uuid"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/book"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/jsonbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/nullablebook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/pricepolicy"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/taggedbook"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// Book is the client for interacting with the Book builders.
+	Book *BookClient
+	// JSONBook is the client for interacting with the JSONBook builders.
+	JSONBook *JSONBookClient
+	// NullableBook is the client for interacting with the NullableBook builders.
+	NullableBook *NullableBookClient
+	// PricePolicy is the client for interacting with the PricePolicy builders.
+	PricePolicy *PricePolicyClient
+	// TaggedBook is the client for interacting with the TaggedBook builders.
+	TaggedBook *TaggedBookClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	client := &Client{config: newConfig(opts...)}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.Book = NewBookClient(c.config)
+	c.JSONBook = NewJSONBookClient(c.config)
+	c.NullableBook = NewNullableBookClient(c.config)
+	c.PricePolicy = NewPricePolicyClient(c.config)
+	c.TaggedBook = NewTaggedBookClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("entuuid: cannot start a transaction within a transaction")
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, ErrTxStarted
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("entuuid: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:          ctx,
+		config:       cfg,
+		Book:         NewBookClient(cfg),
+		JSONBook:     NewJSONBookClient(cfg),
+		NullableBook: NewNullableBookClient(cfg),
+		PricePolicy:  NewPricePolicyClient(cfg),
+		TaggedBook:   NewTaggedBookClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:          ctx,
+		config:       cfg,
+		Book:         NewBookClient(cfg),
+		JSONBook:     NewJSONBookClient(cfg),
+		NullableBook: NewNullableBookClient(cfg),
+		PricePolicy:  NewPricePolicyClient(cfg),
+		TaggedBook:   NewTaggedBookClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		Book.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.Book.Use(hooks...)
+	c.JSONBook.Use(hooks...)
+	c.NullableBook.Use(hooks...)
+	c.PricePolicy.Use(hooks...)
+	c.TaggedBook.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Book.Intercept(interceptors...)
+	c.JSONBook.Intercept(interceptors...)
+	c.NullableBook.Intercept(interceptors...)
+	c.PricePolicy.Intercept(interceptors...)
+	c.TaggedBook.Intercept(interceptors...)
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *BookMutation:
+		return c.Book.mutate(ctx, m)
+	case *JSONBookMutation:
+		return c.JSONBook.mutate(ctx, m)
+	case *NullableBookMutation:
+		return c.NullableBook.mutate(ctx, m)
+	case *PricePolicyMutation:
+		return c.PricePolicy.mutate(ctx, m)
+	case *TaggedBookMutation:
+		return c.TaggedBook.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("entuuid: unknown mutation type %T", m)
+	}
+}
+
+// BookClient is a client for the Book schema.
+type BookClient struct {
+	config
+}
+
+// NewBookClient returns a client for the Book from the given config.
+func NewBookClient(c config) *BookClient {
+	return &BookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `book.Hooks(f(g(h())))`.
+func (c *BookClient) Use(hooks ...Hook) {
+	c.hooks.Book = append(c.hooks.Book, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `book.Intercept(f(g(h())))`.
+func (c *BookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Book = append(c.inters.Book, interceptors...)
+}
+
+// Create returns a builder for creating a Book entity.
+func (c *BookClient) Create() *BookCreate {
+	mutation := newBookMutation(c.config, OpCreate)
+	return &BookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Book entities.
+func (c *BookClient) CreateBulk(builders ...*BookCreate) *BookCreateBulk {
+	return &BookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BookClient) MapCreateBulk(slice any, setFunc func(*BookCreate, int)) *BookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BookCreateBulk{err: fmt.Errorf("calling to BookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Book.
+func (c *BookClient) Update() *BookUpdate {
+	mutation := newBookMutation(c.config, OpUpdate)
+	return &BookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BookClient) UpdateOne(b *Book) *BookUpdateOne {
+	mutation := newBookMutation(c.config, OpUpdateOne, withBook(b))
+	return &BookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BookClient) UpdateOneID(id uuid.UUID) *BookUpdateOne {
+	mutation := newBookMutation(c.config, OpUpdateOne, withBookID(id))
+	return &BookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Book.
+func (c *BookClient) Delete() *BookDelete {
+	mutation := newBookMutation(c.config, OpDelete)
+	return &BookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BookClient) DeleteOne(b *Book) *BookDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BookClient) DeleteOneID(id uuid.UUID) *BookDeleteOne {
+	builder := c.Delete().Where(book.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BookDeleteOne{builder}
+}
+
+// Query returns a query builder for Book.
+func (c *BookClient) Query() *BookQuery {
+	return &BookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Book entity by its id.
+func (c *BookClient) Get(ctx context.Context, id uuid.UUID) (*Book, error) {
+	return c.Query().Where(book.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BookClient) GetX(ctx context.Context, id uuid.UUID) *Book {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPolicies queries the policies edge of a Book.
+func (c *BookClient) QueryPolicies(b *Book) *PricePolicyQuery {
+	query := (&PricePolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(pricepolicy.Table, pricepolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.PoliciesTable, book.PoliciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BookClient) Hooks() []Hook {
+	return c.hooks.Book
+}
+
+// Interceptors returns the client interceptors.
+func (c *BookClient) Interceptors() []Interceptor {
+	return c.inters.Book
+}
+
+func (c *BookClient) mutate(ctx context.Context, m *BookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entuuid: unknown Book mutation op: %q", m.Op())
+	}
+}
+
+// JSONBookClient is a client for the JSONBook schema.
+type JSONBookClient struct {
+	config
+}
+
+// NewJSONBookClient returns a client for the JSONBook from the given config.
+func NewJSONBookClient(c config) *JSONBookClient {
+	return &JSONBookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `jsonbook.Hooks(f(g(h())))`.
+func (c *JSONBookClient) Use(hooks ...Hook) {
+	c.hooks.JSONBook = append(c.hooks.JSONBook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `jsonbook.Intercept(f(g(h())))`.
+func (c *JSONBookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.JSONBook = append(c.inters.JSONBook, interceptors...)
+}
+
+// Create returns a builder for creating a JSONBook entity.
+func (c *JSONBookClient) Create() *JSONBookCreate {
+	mutation := newJSONBookMutation(c.config, OpCreate)
+	return &JSONBookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of JSONBook entities.
+func (c *JSONBookClient) CreateBulk(builders ...*JSONBookCreate) *JSONBookCreateBulk {
+	return &JSONBookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JSONBookClient) MapCreateBulk(slice any, setFunc func(*JSONBookCreate, int)) *JSONBookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JSONBookCreateBulk{err: fmt.Errorf("calling to JSONBookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JSONBookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JSONBookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for JSONBook.
+func (c *JSONBookClient) Update() *JSONBookUpdate {
+	mutation := newJSONBookMutation(c.config, OpUpdate)
+	return &JSONBookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JSONBookClient) UpdateOne(jb *JSONBook) *JSONBookUpdateOne {
+	mutation := newJSONBookMutation(c.config, OpUpdateOne, withJSONBook(jb))
+	return &JSONBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JSONBookClient) UpdateOneID(id uuid.UUID) *JSONBookUpdateOne {
+	mutation := newJSONBookMutation(c.config, OpUpdateOne, withJSONBookID(id))
+	return &JSONBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for JSONBook.
+func (c *JSONBookClient) Delete() *JSONBookDelete {
+	mutation := newJSONBookMutation(c.config, OpDelete)
+	return &JSONBookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JSONBookClient) DeleteOne(jb *JSONBook) *JSONBookDeleteOne {
+	return c.DeleteOneID(jb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JSONBookClient) DeleteOneID(id uuid.UUID) *JSONBookDeleteOne {
+	builder := c.Delete().Where(jsonbook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JSONBookDeleteOne{builder}
+}
+
+// Query returns a query builder for JSONBook.
+func (c *JSONBookClient) Query() *JSONBookQuery {
+	return &JSONBookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJSONBook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a JSONBook entity by its id.
+func (c *JSONBookClient) Get(ctx context.Context, id uuid.UUID) (*JSONBook, error) {
+	return c.Query().Where(jsonbook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JSONBookClient) GetX(ctx context.Context, id uuid.UUID) *JSONBook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *JSONBookClient) Hooks() []Hook {
+	return c.hooks.JSONBook
+}
+
+// Interceptors returns the client interceptors.
+func (c *JSONBookClient) Interceptors() []Interceptor {
+	return c.inters.JSONBook
+}
+
+func (c *JSONBookClient) mutate(ctx context.Context, m *JSONBookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JSONBookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JSONBookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JSONBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JSONBookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entuuid: unknown JSONBook mutation op: %q", m.Op())
+	}
+}
+
+// NullableBookClient is a client for the NullableBook schema.
+type NullableBookClient struct {
+	config
+}
+
+// NewNullableBookClient returns a client for the NullableBook from the given config.
+func NewNullableBookClient(c config) *NullableBookClient {
+	return &NullableBookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `nullablebook.Hooks(f(g(h())))`.
+func (c *NullableBookClient) Use(hooks ...Hook) {
+	c.hooks.NullableBook = append(c.hooks.NullableBook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `nullablebook.Intercept(f(g(h())))`.
+func (c *NullableBookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NullableBook = append(c.inters.NullableBook, interceptors...)
+}
+
+// Create returns a builder for creating a NullableBook entity.
+func (c *NullableBookClient) Create() *NullableBookCreate {
+	mutation := newNullableBookMutation(c.config, OpCreate)
+	return &NullableBookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NullableBook entities.
+func (c *NullableBookClient) CreateBulk(builders ...*NullableBookCreate) *NullableBookCreateBulk {
+	return &NullableBookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NullableBookClient) MapCreateBulk(slice any, setFunc func(*NullableBookCreate, int)) *NullableBookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NullableBookCreateBulk{err: fmt.Errorf("calling to NullableBookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NullableBookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NullableBookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NullableBook.
+func (c *NullableBookClient) Update() *NullableBookUpdate {
+	mutation := newNullableBookMutation(c.config, OpUpdate)
+	return &NullableBookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NullableBookClient) UpdateOne(nb *NullableBook) *NullableBookUpdateOne {
+	mutation := newNullableBookMutation(c.config, OpUpdateOne, withNullableBook(nb))
+	return &NullableBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NullableBookClient) UpdateOneID(id uuid.UUID) *NullableBookUpdateOne {
+	mutation := newNullableBookMutation(c.config, OpUpdateOne, withNullableBookID(id))
+	return &NullableBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NullableBook.
+func (c *NullableBookClient) Delete() *NullableBookDelete {
+	mutation := newNullableBookMutation(c.config, OpDelete)
+	return &NullableBookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NullableBookClient) DeleteOne(nb *NullableBook) *NullableBookDeleteOne {
+	return c.DeleteOneID(nb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NullableBookClient) DeleteOneID(id uuid.UUID) *NullableBookDeleteOne {
+	builder := c.Delete().Where(nullablebook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NullableBookDeleteOne{builder}
+}
+
+// Query returns a query builder for NullableBook.
+func (c *NullableBookClient) Query() *NullableBookQuery {
+	return &NullableBookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNullableBook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NullableBook entity by its id.
+func (c *NullableBookClient) Get(ctx context.Context, id uuid.UUID) (*NullableBook, error) {
+	return c.Query().Where(nullablebook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NullableBookClient) GetX(ctx context.Context, id uuid.UUID) *NullableBook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NullableBookClient) Hooks() []Hook {
+	return c.hooks.NullableBook
+}
+
+// Interceptors returns the client interceptors.
+func (c *NullableBookClient) Interceptors() []Interceptor {
+	return c.inters.NullableBook
+}
+
+func (c *NullableBookClient) mutate(ctx context.Context, m *NullableBookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NullableBookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NullableBookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NullableBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NullableBookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entuuid: unknown NullableBook mutation op: %q", m.Op())
+	}
+}
+
+// PricePolicyClient is a client for the PricePolicy schema.
+type PricePolicyClient struct {
+	config
+}
+
+// NewPricePolicyClient returns a client for the PricePolicy from the given config.
+func NewPricePolicyClient(c config) *PricePolicyClient {
+	return &PricePolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pricepolicy.Hooks(f(g(h())))`.
+func (c *PricePolicyClient) Use(hooks ...Hook) {
+	c.hooks.PricePolicy = append(c.hooks.PricePolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pricepolicy.Intercept(f(g(h())))`.
+func (c *PricePolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PricePolicy = append(c.inters.PricePolicy, interceptors...)
+}
+
+// Create returns a builder for creating a PricePolicy entity.
+func (c *PricePolicyClient) Create() *PricePolicyCreate {
+	mutation := newPricePolicyMutation(c.config, OpCreate)
+	return &PricePolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PricePolicy entities.
+func (c *PricePolicyClient) CreateBulk(builders ...*PricePolicyCreate) *PricePolicyCreateBulk {
+	return &PricePolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PricePolicyClient) MapCreateBulk(slice any, setFunc func(*PricePolicyCreate, int)) *PricePolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PricePolicyCreateBulk{err: fmt.Errorf("calling to PricePolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PricePolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PricePolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PricePolicy.
+func (c *PricePolicyClient) Update() *PricePolicyUpdate {
+	mutation := newPricePolicyMutation(c.config, OpUpdate)
+	return &PricePolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PricePolicyClient) UpdateOne(pp *PricePolicy) *PricePolicyUpdateOne {
+	mutation := newPricePolicyMutation(c.config, OpUpdateOne, withPricePolicy(pp))
+	return &PricePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PricePolicyClient) UpdateOneID(id uuid.UUID) *PricePolicyUpdateOne {
+	mutation := newPricePolicyMutation(c.config, OpUpdateOne, withPricePolicyID(id))
+	return &PricePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PricePolicy.
+func (c *PricePolicyClient) Delete() *PricePolicyDelete {
+	mutation := newPricePolicyMutation(c.config, OpDelete)
+	return &PricePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PricePolicyClient) DeleteOne(pp *PricePolicy) *PricePolicyDeleteOne {
+	return c.DeleteOneID(pp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PricePolicyClient) DeleteOneID(id uuid.UUID) *PricePolicyDeleteOne {
+	builder := c.Delete().Where(pricepolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PricePolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for PricePolicy.
+func (c *PricePolicyClient) Query() *PricePolicyQuery {
+	return &PricePolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePricePolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PricePolicy entity by its id.
+func (c *PricePolicyClient) Get(ctx context.Context, id uuid.UUID) (*PricePolicy, error) {
+	return c.Query().Where(pricepolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PricePolicyClient) GetX(ctx context.Context, id uuid.UUID) *PricePolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBook queries the book edge of a PricePolicy.
+func (c *PricePolicyClient) QueryBook(pp *PricePolicy) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pricepolicy.Table, pricepolicy.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pricepolicy.BookTable, pricepolicy.BookColumn),
+		)
+		fromV = sqlgraph.Neighbors(pp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PricePolicyClient) Hooks() []Hook {
+	return c.hooks.PricePolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *PricePolicyClient) Interceptors() []Interceptor {
+	return c.inters.PricePolicy
+}
+
+func (c *PricePolicyClient) mutate(ctx context.Context, m *PricePolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PricePolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PricePolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PricePolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PricePolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entuuid: unknown PricePolicy mutation op: %q", m.Op())
+	}
+}
+
+// TaggedBookClient is a client for the TaggedBook schema.
+type TaggedBookClient struct {
+	config
+}
+
+// NewTaggedBookClient returns a client for the TaggedBook from the given config.
+func NewTaggedBookClient(c config) *TaggedBookClient {
+	return &TaggedBookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taggedbook.Hooks(f(g(h())))`.
+func (c *TaggedBookClient) Use(hooks ...Hook) {
+	c.hooks.TaggedBook = append(c.hooks.TaggedBook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taggedbook.Intercept(f(g(h())))`.
+func (c *TaggedBookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaggedBook = append(c.inters.TaggedBook, interceptors...)
+}
+
+// Create returns a builder for creating a TaggedBook entity.
+func (c *TaggedBookClient) Create() *TaggedBookCreate {
+	mutation := newTaggedBookMutation(c.config, OpCreate)
+	return &TaggedBookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaggedBook entities.
+func (c *TaggedBookClient) CreateBulk(builders ...*TaggedBookCreate) *TaggedBookCreateBulk {
+	return &TaggedBookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaggedBookClient) MapCreateBulk(slice any, setFunc func(*TaggedBookCreate, int)) *TaggedBookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaggedBookCreateBulk{err: fmt.Errorf("calling to TaggedBookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaggedBookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaggedBookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaggedBook.
+func (c *TaggedBookClient) Update() *TaggedBookUpdate {
+	mutation := newTaggedBookMutation(c.config, OpUpdate)
+	return &TaggedBookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaggedBookClient) UpdateOne(tb *TaggedBook) *TaggedBookUpdateOne {
+	mutation := newTaggedBookMutation(c.config, OpUpdateOne, withTaggedBook(tb))
+	return &TaggedBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaggedBookClient) UpdateOneID(id uuid.UUID) *TaggedBookUpdateOne {
+	mutation := newTaggedBookMutation(c.config, OpUpdateOne, withTaggedBookID(id))
+	return &TaggedBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaggedBook.
+func (c *TaggedBookClient) Delete() *TaggedBookDelete {
+	mutation := newTaggedBookMutation(c.config, OpDelete)
+	return &TaggedBookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaggedBookClient) DeleteOne(tb *TaggedBook) *TaggedBookDeleteOne {
+	return c.DeleteOneID(tb.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaggedBookClient) DeleteOneID(id uuid.UUID) *TaggedBookDeleteOne {
+	builder := c.Delete().Where(taggedbook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaggedBookDeleteOne{builder}
+}
+
+// Query returns a query builder for TaggedBook.
+func (c *TaggedBookClient) Query() *TaggedBookQuery {
+	return &TaggedBookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaggedBook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaggedBook entity by its id.
+func (c *TaggedBookClient) Get(ctx context.Context, id uuid.UUID) (*TaggedBook, error) {
+	return c.Query().Where(taggedbook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaggedBookClient) GetX(ctx context.Context, id uuid.UUID) *TaggedBook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaggedBookClient) Hooks() []Hook {
+	return c.hooks.TaggedBook
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaggedBookClient) Interceptors() []Interceptor {
+	return c.inters.TaggedBook
+}
+
+func (c *TaggedBookClient) mutate(ctx context.Context, m *TaggedBookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaggedBookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaggedBookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaggedBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaggedBookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("entuuid: unknown TaggedBook mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		Book, JSONBook, NullableBook, PricePolicy, TaggedBook []ent.Hook
+	}
+	inters struct {
+		Book, JSONBook, NullableBook, PricePolicy, TaggedBook []ent.Interceptor
+	}
+)
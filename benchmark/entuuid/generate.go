@@ -0,0 +1,3 @@
+package entuuid
+
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/upsert ./schema
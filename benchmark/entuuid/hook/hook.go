@@ -0,0 +1,247 @@
+// Code generated by ent, DO NOT EDIT.
+
+package hook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid"
+)
+
+// The BookFunc type is an adapter to allow the use of ordinary
+// function as Book mutator.
+type BookFunc func(context.Context, *entuuid.BookMutation) (entuuid.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BookFunc) Mutate(ctx context.Context, m entuuid.Mutation) (entuuid.Value, error) {
+	if mv, ok := m.(*entuuid.BookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entuuid.BookMutation", m)
+}
+
+// The JSONBookFunc type is an adapter to allow the use of ordinary
+// function as JSONBook mutator.
+type JSONBookFunc func(context.Context, *entuuid.JSONBookMutation) (entuuid.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JSONBookFunc) Mutate(ctx context.Context, m entuuid.Mutation) (entuuid.Value, error) {
+	if mv, ok := m.(*entuuid.JSONBookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entuuid.JSONBookMutation", m)
+}
+
+// The NullableBookFunc type is an adapter to allow the use of ordinary
+// function as NullableBook mutator.
+type NullableBookFunc func(context.Context, *entuuid.NullableBookMutation) (entuuid.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NullableBookFunc) Mutate(ctx context.Context, m entuuid.Mutation) (entuuid.Value, error) {
+	if mv, ok := m.(*entuuid.NullableBookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entuuid.NullableBookMutation", m)
+}
+
+// The PricePolicyFunc type is an adapter to allow the use of ordinary
+// function as PricePolicy mutator.
+type PricePolicyFunc func(context.Context, *entuuid.PricePolicyMutation) (entuuid.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PricePolicyFunc) Mutate(ctx context.Context, m entuuid.Mutation) (entuuid.Value, error) {
+	if mv, ok := m.(*entuuid.PricePolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entuuid.PricePolicyMutation", m)
+}
+
+// The TaggedBookFunc type is an adapter to allow the use of ordinary
+// function as TaggedBook mutator.
+type TaggedBookFunc func(context.Context, *entuuid.TaggedBookMutation) (entuuid.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaggedBookFunc) Mutate(ctx context.Context, m entuuid.Mutation) (entuuid.Value, error) {
+	if mv, ok := m.(*entuuid.TaggedBookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *entuuid.TaggedBookMutation", m)
+}
+
+// Condition is a hook condition function.
+type Condition func(context.Context, entuuid.Mutation) bool
+
+// And groups conditions with the AND operator.
+func And(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m entuuid.Mutation) bool {
+		if !first(ctx, m) || !second(ctx, m) {
+			return false
+		}
+		for _, cond := range rest {
+			if !cond(ctx, m) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or groups conditions with the OR operator.
+func Or(first, second Condition, rest ...Condition) Condition {
+	return func(ctx context.Context, m entuuid.Mutation) bool {
+		if first(ctx, m) || second(ctx, m) {
+			return true
+		}
+		for _, cond := range rest {
+			if cond(ctx, m) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not negates a given condition.
+func Not(cond Condition) Condition {
+	return func(ctx context.Context, m entuuid.Mutation) bool {
+		return !cond(ctx, m)
+	}
+}
+
+// HasOp is a condition testing mutation operation.
+func HasOp(op entuuid.Op) Condition {
+	return func(_ context.Context, m entuuid.Mutation) bool {
+		return m.Op().Is(op)
+	}
+}
+
+// HasAddedFields is a condition validating `.AddedField` on fields.
+func HasAddedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m entuuid.Mutation) bool {
+		if _, exists := m.AddedField(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.AddedField(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasClearedFields is a condition validating `.FieldCleared` on fields.
+func HasClearedFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m entuuid.Mutation) bool {
+		if exists := m.FieldCleared(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if exists := m.FieldCleared(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasFields is a condition validating `.Field` on fields.
+func HasFields(field string, fields ...string) Condition {
+	return func(_ context.Context, m entuuid.Mutation) bool {
+		if _, exists := m.Field(field); !exists {
+			return false
+		}
+		for _, field := range fields {
+			if _, exists := m.Field(field); !exists {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// If executes the given hook under condition.
+//
+//	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
+func If(hk entuuid.Hook, cond Condition) entuuid.Hook {
+	return func(next entuuid.Mutator) entuuid.Mutator {
+		return entuuid.MutateFunc(func(ctx context.Context, m entuuid.Mutation) (entuuid.Value, error) {
+			if cond(ctx, m) {
+				return hk(next).Mutate(ctx, m)
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// On executes the given hook only for the given operation.
+//
+//	hook.On(Log, entuuid.Delete|entuuid.Create)
+func On(hk entuuid.Hook, op entuuid.Op) entuuid.Hook {
+	return If(hk, HasOp(op))
+}
+
+// Unless skips the given hook only for the given operation.
+//
+//	hook.Unless(Log, entuuid.Update|entuuid.UpdateOne)
+func Unless(hk entuuid.Hook, op entuuid.Op) entuuid.Hook {
+	return If(hk, Not(HasOp(op)))
+}
+
+// FixedError is a hook returning a fixed error.
+func FixedError(err error) entuuid.Hook {
+	return func(entuuid.Mutator) entuuid.Mutator {
+		return entuuid.MutateFunc(func(context.Context, entuuid.Mutation) (entuuid.Value, error) {
+			return nil, err
+		})
+	}
+}
+
+// Reject returns a hook that rejects all operations that match op.
+//
+//	func (T) Hooks() []entuuid.Hook {
+//		return []entuuid.Hook{
+//			Reject(entuuid.Delete|entuuid.Update),
+//		}
+//	}
+func Reject(op entuuid.Op) entuuid.Hook {
+	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
+	return On(hk, op)
+}
+
+// Chain acts as a list of hooks and is effectively immutable.
+// Once created, it will always hold the same set of hooks in the same order.
+type Chain struct {
+	hooks []entuuid.Hook
+}
+
+// NewChain creates a new chain of hooks.
+func NewChain(hooks ...entuuid.Hook) Chain {
+	return Chain{append([]entuuid.Hook(nil), hooks...)}
+}
+
+// Hook chains the list of hooks and returns the final hook.
+func (c Chain) Hook() entuuid.Hook {
+	return func(mutator entuuid.Mutator) entuuid.Mutator {
+		for i := len(c.hooks) - 1; i >= 0; i-- {
+			mutator = c.hooks[i](mutator)
+		}
+		return mutator
+	}
+}
+
+// Append extends a chain, adding the specified hook
+// as the last ones in the mutation flow.
+func (c Chain) Append(hooks ...entuuid.Hook) Chain {
+	newHooks := make([]entuuid.Hook, 0, len(c.hooks)+len(hooks))
+	newHooks = append(newHooks, c.hooks...)
+	newHooks = append(newHooks, hooks...)
+	return Chain{newHooks}
+}
+
+// Extend extends a chain, adding the specified chain
+// as the last ones in the mutation flow.
+func (c Chain) Extend(chain Chain) Chain {
+	return c.Append(chain.hooks...)
+}
@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/jsonbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+	"github.com/google/uuid"
+)
+
+// JSONBook is the model entity for the JSONBook schema.
+type JSONBook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Isbn holds the value of the "isbn" field.
+	Isbn string `json:"isbn,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// Genre holds the value of the "genre" field.
+	Genre string `json:"genre,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// PublicizedAt holds the value of the "publicized_at" field.
+	PublicizedAt time.Time `json:"publicized_at,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata     model.BookMetadata `json:"metadata,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JSONBook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jsonbook.FieldMetadata:
+			values[i] = new([]byte)
+		case jsonbook.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case jsonbook.FieldIsbn, jsonbook.FieldTitle, jsonbook.FieldAuthor, jsonbook.FieldGenre:
+			values[i] = new(sql.NullString)
+		case jsonbook.FieldPublicizedAt:
+			values[i] = new(sql.NullTime)
+		case jsonbook.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JSONBook fields.
+func (jb *JSONBook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jsonbook.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				jb.ID = *value
+			}
+		case jsonbook.FieldIsbn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field isbn", values[i])
+			} else if value.Valid {
+				jb.Isbn = value.String
+			}
+		case jsonbook.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				jb.Title = value.String
+			}
+		case jsonbook.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				jb.Author = value.String
+			}
+		case jsonbook.FieldGenre:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field genre", values[i])
+			} else if value.Valid {
+				jb.Genre = value.String
+			}
+		case jsonbook.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				jb.Quantity = int(value.Int64)
+			}
+		case jsonbook.FieldPublicizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field publicized_at", values[i])
+			} else if value.Valid {
+				jb.PublicizedAt = value.Time
+			}
+		case jsonbook.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &jb.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			jb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JSONBook.
+// This includes values selected through modifiers, order, etc.
+func (jb *JSONBook) Value(name string) (ent.Value, error) {
+	return jb.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this JSONBook.
+// Note that you need to call JSONBook.Unwrap() before calling this method if this JSONBook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (jb *JSONBook) Update() *JSONBookUpdateOne {
+	return NewJSONBookClient(jb.config).UpdateOne(jb)
+}
+
+// Unwrap unwraps the JSONBook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (jb *JSONBook) Unwrap() *JSONBook {
+	_tx, ok := jb.config.driver.(*txDriver)
+	if !ok {
+		panic("entuuid: JSONBook is not a transactional entity")
+	}
+	jb.config.driver = _tx.drv
+	return jb
+}
+
+// String implements the fmt.Stringer.
+func (jb *JSONBook) String() string {
+	var builder strings.Builder
+	builder.WriteString("JSONBook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", jb.ID))
+	builder.WriteString("isbn=")
+	builder.WriteString(jb.Isbn)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(jb.Title)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(jb.Author)
+	builder.WriteString(", ")
+	builder.WriteString("genre=")
+	builder.WriteString(jb.Genre)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", jb.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("publicized_at=")
+	builder.WriteString(jb.PublicizedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", jb.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JSONBooks is a parsable slice of JSONBook.
+type JSONBooks []*JSONBook
@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/jsonbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+	"github.com/google/uuid"
+)
+
+// JSONBookCreate is the builder for creating a JSONBook entity.
+type JSONBookCreate struct {
+	config
+	mutation *JSONBookMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetIsbn sets the "isbn" field.
+func (jbc *JSONBookCreate) SetIsbn(s string) *JSONBookCreate {
+	jbc.mutation.SetIsbn(s)
+	return jbc
+}
+
+// SetTitle sets the "title" field.
+func (jbc *JSONBookCreate) SetTitle(s string) *JSONBookCreate {
+	jbc.mutation.SetTitle(s)
+	return jbc
+}
+
+// SetAuthor sets the "author" field.
+func (jbc *JSONBookCreate) SetAuthor(s string) *JSONBookCreate {
+	jbc.mutation.SetAuthor(s)
+	return jbc
+}
+
+// SetGenre sets the "genre" field.
+func (jbc *JSONBookCreate) SetGenre(s string) *JSONBookCreate {
+	jbc.mutation.SetGenre(s)
+	return jbc
+}
+
+// SetQuantity sets the "quantity" field.
+func (jbc *JSONBookCreate) SetQuantity(i int) *JSONBookCreate {
+	jbc.mutation.SetQuantity(i)
+	return jbc
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (jbc *JSONBookCreate) SetPublicizedAt(t time.Time) *JSONBookCreate {
+	jbc.mutation.SetPublicizedAt(t)
+	return jbc
+}
+
+// SetMetadata sets the "metadata" field.
+func (jbc *JSONBookCreate) SetMetadata(mm model.BookMetadata) *JSONBookCreate {
+	jbc.mutation.SetMetadata(mm)
+	return jbc
+}
+
+// SetID sets the "id" field.
+func (jbc *JSONBookCreate) SetID(u uuid.UUID) *JSONBookCreate {
+	jbc.mutation.SetID(u)
+	return jbc
+}
+
+// Mutation returns the JSONBookMutation object of the builder.
+func (jbc *JSONBookCreate) Mutation() *JSONBookMutation {
+	return jbc.mutation
+}
+
+// Save creates the JSONBook in the database.
+func (jbc *JSONBookCreate) Save(ctx context.Context) (*JSONBook, error) {
+	return withHooks(ctx, jbc.sqlSave, jbc.mutation, jbc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jbc *JSONBookCreate) SaveX(ctx context.Context) *JSONBook {
+	v, err := jbc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jbc *JSONBookCreate) Exec(ctx context.Context) error {
+	_, err := jbc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jbc *JSONBookCreate) ExecX(ctx context.Context) {
+	if err := jbc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jbc *JSONBookCreate) check() error {
+	if _, ok := jbc.mutation.Isbn(); !ok {
+		return &ValidationError{Name: "isbn", err: errors.New(`entuuid: missing required field "JSONBook.isbn"`)}
+	}
+	if _, ok := jbc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`entuuid: missing required field "JSONBook.title"`)}
+	}
+	if _, ok := jbc.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`entuuid: missing required field "JSONBook.author"`)}
+	}
+	if _, ok := jbc.mutation.Genre(); !ok {
+		return &ValidationError{Name: "genre", err: errors.New(`entuuid: missing required field "JSONBook.genre"`)}
+	}
+	if _, ok := jbc.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`entuuid: missing required field "JSONBook.quantity"`)}
+	}
+	if _, ok := jbc.mutation.PublicizedAt(); !ok {
+		return &ValidationError{Name: "publicized_at", err: errors.New(`entuuid: missing required field "JSONBook.publicized_at"`)}
+	}
+	if _, ok := jbc.mutation.Metadata(); !ok {
+		return &ValidationError{Name: "metadata", err: errors.New(`entuuid: missing required field "JSONBook.metadata"`)}
+	}
+	return nil
+}
+
+func (jbc *JSONBookCreate) sqlSave(ctx context.Context) (*JSONBook, error) {
+	if err := jbc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jbc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jbc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	jbc.mutation.id = &_node.ID
+	jbc.mutation.done = true
+	return _node, nil
+}
+
+func (jbc *JSONBookCreate) createSpec() (*JSONBook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JSONBook{config: jbc.config}
+		_spec = sqlgraph.NewCreateSpec(jsonbook.Table, sqlgraph.NewFieldSpec(jsonbook.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = jbc.conflict
+	if id, ok := jbc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := jbc.mutation.Isbn(); ok {
+		_spec.SetField(jsonbook.FieldIsbn, field.TypeString, value)
+		_node.Isbn = value
+	}
+	if value, ok := jbc.mutation.Title(); ok {
+		_spec.SetField(jsonbook.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := jbc.mutation.Author(); ok {
+		_spec.SetField(jsonbook.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := jbc.mutation.Genre(); ok {
+		_spec.SetField(jsonbook.FieldGenre, field.TypeString, value)
+		_node.Genre = value
+	}
+	if value, ok := jbc.mutation.Quantity(); ok {
+		_spec.SetField(jsonbook.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := jbc.mutation.PublicizedAt(); ok {
+		_spec.SetField(jsonbook.FieldPublicizedAt, field.TypeTime, value)
+		_node.PublicizedAt = value
+	}
+	if value, ok := jbc.mutation.Metadata(); ok {
+		_spec.SetField(jsonbook.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JSONBook.Create().
+//		SetIsbn(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JSONBookUpsert) {
+//			SetIsbn(v+v).
+//		}).
+//		Exec(ctx)
+func (jbc *JSONBookCreate) OnConflict(opts ...sql.ConflictOption) *JSONBookUpsertOne {
+	jbc.conflict = opts
+	return &JSONBookUpsertOne{
+		create: jbc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JSONBook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jbc *JSONBookCreate) OnConflictColumns(columns ...string) *JSONBookUpsertOne {
+	jbc.conflict = append(jbc.conflict, sql.ConflictColumns(columns...))
+	return &JSONBookUpsertOne{
+		create: jbc,
+	}
+}
+
+type (
+	// JSONBookUpsertOne is the builder for "upsert"-ing
+	//  one JSONBook node.
+	JSONBookUpsertOne struct {
+		create *JSONBookCreate
+	}
+
+	// JSONBookUpsert is the "OnConflict" setter.
+	JSONBookUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetIsbn sets the "isbn" field.
+func (u *JSONBookUpsert) SetIsbn(v string) *JSONBookUpsert {
+	u.Set(jsonbook.FieldIsbn, v)
+	return u
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *JSONBookUpsert) UpdateIsbn() *JSONBookUpsert {
+	u.SetExcluded(jsonbook.FieldIsbn)
+	return u
+}
+
+// SetTitle sets the "title" field.
+func (u *JSONBookUpsert) SetTitle(v string) *JSONBookUpsert {
+	u.Set(jsonbook.FieldTitle, v)
+	return u
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *JSONBookUpsert) UpdateTitle() *JSONBookUpsert {
+	u.SetExcluded(jsonbook.FieldTitle)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *JSONBookUpsert) SetAuthor(v string) *JSONBookUpsert {
+	u.Set(jsonbook.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *JSONBookUpsert) UpdateAuthor() *JSONBookUpsert {
+	u.SetExcluded(jsonbook.FieldAuthor)
+	return u
+}
+
+// SetGenre sets the "genre" field.
+func (u *JSONBookUpsert) SetGenre(v string) *JSONBookUpsert {
+	u.Set(jsonbook.FieldGenre, v)
+	return u
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *JSONBookUpsert) UpdateGenre() *JSONBookUpsert {
+	u.SetExcluded(jsonbook.FieldGenre)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *JSONBookUpsert) SetQuantity(v int) *JSONBookUpsert {
+	u.Set(jsonbook.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *JSONBookUpsert) UpdateQuantity() *JSONBookUpsert {
+	u.SetExcluded(jsonbook.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *JSONBookUpsert) AddQuantity(v int) *JSONBookUpsert {
+	u.Add(jsonbook.FieldQuantity, v)
+	return u
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *JSONBookUpsert) SetPublicizedAt(v time.Time) *JSONBookUpsert {
+	u.Set(jsonbook.FieldPublicizedAt, v)
+	return u
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *JSONBookUpsert) UpdatePublicizedAt() *JSONBookUpsert {
+	u.SetExcluded(jsonbook.FieldPublicizedAt)
+	return u
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *JSONBookUpsert) SetMetadata(v model.BookMetadata) *JSONBookUpsert {
+	u.Set(jsonbook.FieldMetadata, v)
+	return u
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *JSONBookUpsert) UpdateMetadata() *JSONBookUpsert {
+	u.SetExcluded(jsonbook.FieldMetadata)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.JSONBook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jsonbook.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JSONBookUpsertOne) UpdateNewValues() *JSONBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(jsonbook.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JSONBook.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *JSONBookUpsertOne) Ignore() *JSONBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JSONBookUpsertOne) DoNothing() *JSONBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JSONBookCreate.OnConflict
+// documentation for more info.
+func (u *JSONBookUpsertOne) Update(set func(*JSONBookUpsert)) *JSONBookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JSONBookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetIsbn sets the "isbn" field.
+func (u *JSONBookUpsertOne) SetIsbn(v string) *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetIsbn(v)
+	})
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *JSONBookUpsertOne) UpdateIsbn() *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateIsbn()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *JSONBookUpsertOne) SetTitle(v string) *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *JSONBookUpsertOne) UpdateTitle() *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *JSONBookUpsertOne) SetAuthor(v string) *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *JSONBookUpsertOne) UpdateAuthor() *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetGenre sets the "genre" field.
+func (u *JSONBookUpsertOne) SetGenre(v string) *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetGenre(v)
+	})
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *JSONBookUpsertOne) UpdateGenre() *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateGenre()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *JSONBookUpsertOne) SetQuantity(v int) *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *JSONBookUpsertOne) AddQuantity(v int) *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *JSONBookUpsertOne) UpdateQuantity() *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *JSONBookUpsertOne) SetPublicizedAt(v time.Time) *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetPublicizedAt(v)
+	})
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *JSONBookUpsertOne) UpdatePublicizedAt() *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdatePublicizedAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *JSONBookUpsertOne) SetMetadata(v model.BookMetadata) *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *JSONBookUpsertOne) UpdateMetadata() *JSONBookUpsertOne {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *JSONBookUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("entuuid: missing options for JSONBookCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JSONBookUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *JSONBookUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("entuuid: JSONBookUpsertOne.ID is not supported by MySQL driver. Use JSONBookUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *JSONBookUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// JSONBookCreateBulk is the builder for creating many JSONBook entities in bulk.
+type JSONBookCreateBulk struct {
+	config
+	err      error
+	builders []*JSONBookCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the JSONBook entities in the database.
+func (jbcb *JSONBookCreateBulk) Save(ctx context.Context) ([]*JSONBook, error) {
+	if jbcb.err != nil {
+		return nil, jbcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jbcb.builders))
+	nodes := make([]*JSONBook, len(jbcb.builders))
+	mutators := make([]Mutator, len(jbcb.builders))
+	for i := range jbcb.builders {
+		func(i int, root context.Context) {
+			builder := jbcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JSONBookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jbcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = jbcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jbcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jbcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jbcb *JSONBookCreateBulk) SaveX(ctx context.Context) []*JSONBook {
+	v, err := jbcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jbcb *JSONBookCreateBulk) Exec(ctx context.Context) error {
+	_, err := jbcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jbcb *JSONBookCreateBulk) ExecX(ctx context.Context) {
+	if err := jbcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.JSONBook.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.JSONBookUpsert) {
+//			SetIsbn(v+v).
+//		}).
+//		Exec(ctx)
+func (jbcb *JSONBookCreateBulk) OnConflict(opts ...sql.ConflictOption) *JSONBookUpsertBulk {
+	jbcb.conflict = opts
+	return &JSONBookUpsertBulk{
+		create: jbcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.JSONBook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (jbcb *JSONBookCreateBulk) OnConflictColumns(columns ...string) *JSONBookUpsertBulk {
+	jbcb.conflict = append(jbcb.conflict, sql.ConflictColumns(columns...))
+	return &JSONBookUpsertBulk{
+		create: jbcb,
+	}
+}
+
+// JSONBookUpsertBulk is the builder for "upsert"-ing
+// a bulk of JSONBook nodes.
+type JSONBookUpsertBulk struct {
+	create *JSONBookCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.JSONBook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(jsonbook.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *JSONBookUpsertBulk) UpdateNewValues() *JSONBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(jsonbook.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.JSONBook.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *JSONBookUpsertBulk) Ignore() *JSONBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *JSONBookUpsertBulk) DoNothing() *JSONBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the JSONBookCreateBulk.OnConflict
+// documentation for more info.
+func (u *JSONBookUpsertBulk) Update(set func(*JSONBookUpsert)) *JSONBookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&JSONBookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetIsbn sets the "isbn" field.
+func (u *JSONBookUpsertBulk) SetIsbn(v string) *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetIsbn(v)
+	})
+}
+
+// UpdateIsbn sets the "isbn" field to the value that was provided on create.
+func (u *JSONBookUpsertBulk) UpdateIsbn() *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateIsbn()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *JSONBookUpsertBulk) SetTitle(v string) *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *JSONBookUpsertBulk) UpdateTitle() *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *JSONBookUpsertBulk) SetAuthor(v string) *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *JSONBookUpsertBulk) UpdateAuthor() *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// SetGenre sets the "genre" field.
+func (u *JSONBookUpsertBulk) SetGenre(v string) *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetGenre(v)
+	})
+}
+
+// UpdateGenre sets the "genre" field to the value that was provided on create.
+func (u *JSONBookUpsertBulk) UpdateGenre() *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateGenre()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *JSONBookUpsertBulk) SetQuantity(v int) *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *JSONBookUpsertBulk) AddQuantity(v int) *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *JSONBookUpsertBulk) UpdateQuantity() *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (u *JSONBookUpsertBulk) SetPublicizedAt(v time.Time) *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetPublicizedAt(v)
+	})
+}
+
+// UpdatePublicizedAt sets the "publicized_at" field to the value that was provided on create.
+func (u *JSONBookUpsertBulk) UpdatePublicizedAt() *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdatePublicizedAt()
+	})
+}
+
+// SetMetadata sets the "metadata" field.
+func (u *JSONBookUpsertBulk) SetMetadata(v model.BookMetadata) *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.SetMetadata(v)
+	})
+}
+
+// UpdateMetadata sets the "metadata" field to the value that was provided on create.
+func (u *JSONBookUpsertBulk) UpdateMetadata() *JSONBookUpsertBulk {
+	return u.Update(func(s *JSONBookUpsert) {
+		s.UpdateMetadata()
+	})
+}
+
+// Exec executes the query.
+func (u *JSONBookUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("entuuid: OnConflict was set for builder %d. Set it on the JSONBookCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("entuuid: missing options for JSONBookCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *JSONBookUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
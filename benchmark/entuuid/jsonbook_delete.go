@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/jsonbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/predicate"
+)
+
+// JSONBookDelete is the builder for deleting a JSONBook entity.
+type JSONBookDelete struct {
+	config
+	hooks    []Hook
+	mutation *JSONBookMutation
+}
+
+// Where appends a list predicates to the JSONBookDelete builder.
+func (jbd *JSONBookDelete) Where(ps ...predicate.JSONBook) *JSONBookDelete {
+	jbd.mutation.Where(ps...)
+	return jbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (jbd *JSONBookDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, jbd.sqlExec, jbd.mutation, jbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jbd *JSONBookDelete) ExecX(ctx context.Context) int {
+	n, err := jbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (jbd *JSONBookDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(jsonbook.Table, sqlgraph.NewFieldSpec(jsonbook.FieldID, field.TypeUUID))
+	if ps := jbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, jbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	jbd.mutation.done = true
+	return affected, err
+}
+
+// JSONBookDeleteOne is the builder for deleting a single JSONBook entity.
+type JSONBookDeleteOne struct {
+	jbd *JSONBookDelete
+}
+
+// Where appends a list predicates to the JSONBookDelete builder.
+func (jbdo *JSONBookDeleteOne) Where(ps ...predicate.JSONBook) *JSONBookDeleteOne {
+	jbdo.jbd.mutation.Where(ps...)
+	return jbdo
+}
+
+// Exec executes the deletion query.
+func (jbdo *JSONBookDeleteOne) Exec(ctx context.Context) error {
+	n, err := jbdo.jbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{jsonbook.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jbdo *JSONBookDeleteOne) ExecX(ctx context.Context) {
+	if err := jbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/jsonbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/predicate"
+	"github.com/google/uuid"
+)
+
+// JSONBookQuery is the builder for querying JSONBook entities.
+type JSONBookQuery struct {
+	config
+	ctx        *QueryContext
+	order      []jsonbook.OrderOption
+	inters     []Interceptor
+	predicates []predicate.JSONBook
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the JSONBookQuery builder.
+func (jbq *JSONBookQuery) Where(ps ...predicate.JSONBook) *JSONBookQuery {
+	jbq.predicates = append(jbq.predicates, ps...)
+	return jbq
+}
+
+// Limit the number of records to be returned by this query.
+func (jbq *JSONBookQuery) Limit(limit int) *JSONBookQuery {
+	jbq.ctx.Limit = &limit
+	return jbq
+}
+
+// Offset to start from.
+func (jbq *JSONBookQuery) Offset(offset int) *JSONBookQuery {
+	jbq.ctx.Offset = &offset
+	return jbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (jbq *JSONBookQuery) Unique(unique bool) *JSONBookQuery {
+	jbq.ctx.Unique = &unique
+	return jbq
+}
+
+// Order specifies how the records should be ordered.
+func (jbq *JSONBookQuery) Order(o ...jsonbook.OrderOption) *JSONBookQuery {
+	jbq.order = append(jbq.order, o...)
+	return jbq
+}
+
+// First returns the first JSONBook entity from the query.
+// Returns a *NotFoundError when no JSONBook was found.
+func (jbq *JSONBookQuery) First(ctx context.Context) (*JSONBook, error) {
+	nodes, err := jbq.Limit(1).All(setContextOp(ctx, jbq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{jsonbook.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (jbq *JSONBookQuery) FirstX(ctx context.Context) *JSONBook {
+	node, err := jbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first JSONBook ID from the query.
+// Returns a *NotFoundError when no JSONBook ID was found.
+func (jbq *JSONBookQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = jbq.Limit(1).IDs(setContextOp(ctx, jbq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{jsonbook.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (jbq *JSONBookQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := jbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single JSONBook entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one JSONBook entity is found.
+// Returns a *NotFoundError when no JSONBook entities are found.
+func (jbq *JSONBookQuery) Only(ctx context.Context) (*JSONBook, error) {
+	nodes, err := jbq.Limit(2).All(setContextOp(ctx, jbq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{jsonbook.Label}
+	default:
+		return nil, &NotSingularError{jsonbook.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (jbq *JSONBookQuery) OnlyX(ctx context.Context) *JSONBook {
+	node, err := jbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only JSONBook ID in the query.
+// Returns a *NotSingularError when more than one JSONBook ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (jbq *JSONBookQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = jbq.Limit(2).IDs(setContextOp(ctx, jbq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{jsonbook.Label}
+	default:
+		err = &NotSingularError{jsonbook.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (jbq *JSONBookQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := jbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of JSONBooks.
+func (jbq *JSONBookQuery) All(ctx context.Context) ([]*JSONBook, error) {
+	ctx = setContextOp(ctx, jbq.ctx, "All")
+	if err := jbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*JSONBook, *JSONBookQuery]()
+	return withInterceptors[[]*JSONBook](ctx, jbq, qr, jbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (jbq *JSONBookQuery) AllX(ctx context.Context) []*JSONBook {
+	nodes, err := jbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of JSONBook IDs.
+func (jbq *JSONBookQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if jbq.ctx.Unique == nil && jbq.path != nil {
+		jbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, jbq.ctx, "IDs")
+	if err = jbq.Select(jsonbook.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (jbq *JSONBookQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := jbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (jbq *JSONBookQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, jbq.ctx, "Count")
+	if err := jbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, jbq, querierCount[*JSONBookQuery](), jbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (jbq *JSONBookQuery) CountX(ctx context.Context) int {
+	count, err := jbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (jbq *JSONBookQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, jbq.ctx, "Exist")
+	switch _, err := jbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entuuid: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (jbq *JSONBookQuery) ExistX(ctx context.Context) bool {
+	exist, err := jbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the JSONBookQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (jbq *JSONBookQuery) Clone() *JSONBookQuery {
+	if jbq == nil {
+		return nil
+	}
+	return &JSONBookQuery{
+		config:     jbq.config,
+		ctx:        jbq.ctx.Clone(),
+		order:      append([]jsonbook.OrderOption{}, jbq.order...),
+		inters:     append([]Interceptor{}, jbq.inters...),
+		predicates: append([]predicate.JSONBook{}, jbq.predicates...),
+		// clone intermediate query.
+		sql:  jbq.sql.Clone(),
+		path: jbq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Isbn string `json:"isbn,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.JSONBook.Query().
+//		GroupBy(jsonbook.FieldIsbn).
+//		Aggregate(entuuid.Count()).
+//		Scan(ctx, &v)
+func (jbq *JSONBookQuery) GroupBy(field string, fields ...string) *JSONBookGroupBy {
+	jbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &JSONBookGroupBy{build: jbq}
+	grbuild.flds = &jbq.ctx.Fields
+	grbuild.label = jsonbook.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Isbn string `json:"isbn,omitempty"`
+//	}
+//
+//	client.JSONBook.Query().
+//		Select(jsonbook.FieldIsbn).
+//		Scan(ctx, &v)
+func (jbq *JSONBookQuery) Select(fields ...string) *JSONBookSelect {
+	jbq.ctx.Fields = append(jbq.ctx.Fields, fields...)
+	sbuild := &JSONBookSelect{JSONBookQuery: jbq}
+	sbuild.label = jsonbook.Label
+	sbuild.flds, sbuild.scan = &jbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a JSONBookSelect configured with the given aggregations.
+func (jbq *JSONBookQuery) Aggregate(fns ...AggregateFunc) *JSONBookSelect {
+	return jbq.Select().Aggregate(fns...)
+}
+
+func (jbq *JSONBookQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range jbq.inters {
+		if inter == nil {
+			return fmt.Errorf("entuuid: uninitialized interceptor (forgotten import entuuid/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, jbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range jbq.ctx.Fields {
+		if !jsonbook.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entuuid: invalid field %q for query", f)}
+		}
+	}
+	if jbq.path != nil {
+		prev, err := jbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		jbq.sql = prev
+	}
+	return nil
+}
+
+func (jbq *JSONBookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*JSONBook, error) {
+	var (
+		nodes = []*JSONBook{}
+		_spec = jbq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*JSONBook).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &JSONBook{config: jbq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, jbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (jbq *JSONBookQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := jbq.querySpec()
+	_spec.Node.Columns = jbq.ctx.Fields
+	if len(jbq.ctx.Fields) > 0 {
+		_spec.Unique = jbq.ctx.Unique != nil && *jbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, jbq.driver, _spec)
+}
+
+func (jbq *JSONBookQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(jsonbook.Table, jsonbook.Columns, sqlgraph.NewFieldSpec(jsonbook.FieldID, field.TypeUUID))
+	_spec.From = jbq.sql
+	if unique := jbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if jbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := jbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jsonbook.FieldID)
+		for i := range fields {
+			if fields[i] != jsonbook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := jbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := jbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := jbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := jbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (jbq *JSONBookQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(jbq.driver.Dialect())
+	t1 := builder.Table(jsonbook.Table)
+	columns := jbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = jsonbook.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if jbq.sql != nil {
+		selector = jbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if jbq.ctx.Unique != nil && *jbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range jbq.predicates {
+		p(selector)
+	}
+	for _, p := range jbq.order {
+		p(selector)
+	}
+	if offset := jbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := jbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// JSONBookGroupBy is the group-by builder for JSONBook entities.
+type JSONBookGroupBy struct {
+	selector
+	build *JSONBookQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (jbgb *JSONBookGroupBy) Aggregate(fns ...AggregateFunc) *JSONBookGroupBy {
+	jbgb.fns = append(jbgb.fns, fns...)
+	return jbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jbgb *JSONBookGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, jbgb.build.ctx, "GroupBy")
+	if err := jbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*JSONBookQuery, *JSONBookGroupBy](ctx, jbgb.build, jbgb, jbgb.build.inters, v)
+}
+
+func (jbgb *JSONBookGroupBy) sqlScan(ctx context.Context, root *JSONBookQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(jbgb.fns))
+	for _, fn := range jbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*jbgb.flds)+len(jbgb.fns))
+		for _, f := range *jbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*jbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// JSONBookSelect is the builder for selecting fields of JSONBook entities.
+type JSONBookSelect struct {
+	*JSONBookQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (jbs *JSONBookSelect) Aggregate(fns ...AggregateFunc) *JSONBookSelect {
+	jbs.fns = append(jbs.fns, fns...)
+	return jbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (jbs *JSONBookSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, jbs.ctx, "Select")
+	if err := jbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*JSONBookQuery, *JSONBookSelect](ctx, jbs.JSONBookQuery, jbs, jbs.inters, v)
+}
+
+func (jbs *JSONBookSelect) sqlScan(ctx context.Context, root *JSONBookQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(jbs.fns))
+	for _, fn := range jbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*jbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := jbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
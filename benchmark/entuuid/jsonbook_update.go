@@ -0,0 +1,435 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/jsonbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/predicate"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// JSONBookUpdate is the builder for updating JSONBook entities.
+type JSONBookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JSONBookMutation
+}
+
+// Where appends a list predicates to the JSONBookUpdate builder.
+func (jbu *JSONBookUpdate) Where(ps ...predicate.JSONBook) *JSONBookUpdate {
+	jbu.mutation.Where(ps...)
+	return jbu
+}
+
+// SetIsbn sets the "isbn" field.
+func (jbu *JSONBookUpdate) SetIsbn(s string) *JSONBookUpdate {
+	jbu.mutation.SetIsbn(s)
+	return jbu
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (jbu *JSONBookUpdate) SetNillableIsbn(s *string) *JSONBookUpdate {
+	if s != nil {
+		jbu.SetIsbn(*s)
+	}
+	return jbu
+}
+
+// SetTitle sets the "title" field.
+func (jbu *JSONBookUpdate) SetTitle(s string) *JSONBookUpdate {
+	jbu.mutation.SetTitle(s)
+	return jbu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (jbu *JSONBookUpdate) SetNillableTitle(s *string) *JSONBookUpdate {
+	if s != nil {
+		jbu.SetTitle(*s)
+	}
+	return jbu
+}
+
+// SetAuthor sets the "author" field.
+func (jbu *JSONBookUpdate) SetAuthor(s string) *JSONBookUpdate {
+	jbu.mutation.SetAuthor(s)
+	return jbu
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (jbu *JSONBookUpdate) SetNillableAuthor(s *string) *JSONBookUpdate {
+	if s != nil {
+		jbu.SetAuthor(*s)
+	}
+	return jbu
+}
+
+// SetGenre sets the "genre" field.
+func (jbu *JSONBookUpdate) SetGenre(s string) *JSONBookUpdate {
+	jbu.mutation.SetGenre(s)
+	return jbu
+}
+
+// SetNillableGenre sets the "genre" field if the given value is not nil.
+func (jbu *JSONBookUpdate) SetNillableGenre(s *string) *JSONBookUpdate {
+	if s != nil {
+		jbu.SetGenre(*s)
+	}
+	return jbu
+}
+
+// SetQuantity sets the "quantity" field.
+func (jbu *JSONBookUpdate) SetQuantity(i int) *JSONBookUpdate {
+	jbu.mutation.ResetQuantity()
+	jbu.mutation.SetQuantity(i)
+	return jbu
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (jbu *JSONBookUpdate) SetNillableQuantity(i *int) *JSONBookUpdate {
+	if i != nil {
+		jbu.SetQuantity(*i)
+	}
+	return jbu
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (jbu *JSONBookUpdate) AddQuantity(i int) *JSONBookUpdate {
+	jbu.mutation.AddQuantity(i)
+	return jbu
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (jbu *JSONBookUpdate) SetPublicizedAt(t time.Time) *JSONBookUpdate {
+	jbu.mutation.SetPublicizedAt(t)
+	return jbu
+}
+
+// SetNillablePublicizedAt sets the "publicized_at" field if the given value is not nil.
+func (jbu *JSONBookUpdate) SetNillablePublicizedAt(t *time.Time) *JSONBookUpdate {
+	if t != nil {
+		jbu.SetPublicizedAt(*t)
+	}
+	return jbu
+}
+
+// SetMetadata sets the "metadata" field.
+func (jbu *JSONBookUpdate) SetMetadata(mm model.BookMetadata) *JSONBookUpdate {
+	jbu.mutation.SetMetadata(mm)
+	return jbu
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (jbu *JSONBookUpdate) SetNillableMetadata(mm *model.BookMetadata) *JSONBookUpdate {
+	if mm != nil {
+		jbu.SetMetadata(*mm)
+	}
+	return jbu
+}
+
+// Mutation returns the JSONBookMutation object of the builder.
+func (jbu *JSONBookUpdate) Mutation() *JSONBookMutation {
+	return jbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jbu *JSONBookUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, jbu.sqlSave, jbu.mutation, jbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jbu *JSONBookUpdate) SaveX(ctx context.Context) int {
+	affected, err := jbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jbu *JSONBookUpdate) Exec(ctx context.Context) error {
+	_, err := jbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jbu *JSONBookUpdate) ExecX(ctx context.Context) {
+	if err := jbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jbu *JSONBookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jsonbook.Table, jsonbook.Columns, sqlgraph.NewFieldSpec(jsonbook.FieldID, field.TypeUUID))
+	if ps := jbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jbu.mutation.Isbn(); ok {
+		_spec.SetField(jsonbook.FieldIsbn, field.TypeString, value)
+	}
+	if value, ok := jbu.mutation.Title(); ok {
+		_spec.SetField(jsonbook.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := jbu.mutation.Author(); ok {
+		_spec.SetField(jsonbook.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := jbu.mutation.Genre(); ok {
+		_spec.SetField(jsonbook.FieldGenre, field.TypeString, value)
+	}
+	if value, ok := jbu.mutation.Quantity(); ok {
+		_spec.SetField(jsonbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := jbu.mutation.AddedQuantity(); ok {
+		_spec.AddField(jsonbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := jbu.mutation.PublicizedAt(); ok {
+		_spec.SetField(jsonbook.FieldPublicizedAt, field.TypeTime, value)
+	}
+	if value, ok := jbu.mutation.Metadata(); ok {
+		_spec.SetField(jsonbook.FieldMetadata, field.TypeJSON, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jsonbook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jbu.mutation.done = true
+	return n, nil
+}
+
+// JSONBookUpdateOne is the builder for updating a single JSONBook entity.
+type JSONBookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JSONBookMutation
+}
+
+// SetIsbn sets the "isbn" field.
+func (jbuo *JSONBookUpdateOne) SetIsbn(s string) *JSONBookUpdateOne {
+	jbuo.mutation.SetIsbn(s)
+	return jbuo
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (jbuo *JSONBookUpdateOne) SetNillableIsbn(s *string) *JSONBookUpdateOne {
+	if s != nil {
+		jbuo.SetIsbn(*s)
+	}
+	return jbuo
+}
+
+// SetTitle sets the "title" field.
+func (jbuo *JSONBookUpdateOne) SetTitle(s string) *JSONBookUpdateOne {
+	jbuo.mutation.SetTitle(s)
+	return jbuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (jbuo *JSONBookUpdateOne) SetNillableTitle(s *string) *JSONBookUpdateOne {
+	if s != nil {
+		jbuo.SetTitle(*s)
+	}
+	return jbuo
+}
+
+// SetAuthor sets the "author" field.
+func (jbuo *JSONBookUpdateOne) SetAuthor(s string) *JSONBookUpdateOne {
+	jbuo.mutation.SetAuthor(s)
+	return jbuo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (jbuo *JSONBookUpdateOne) SetNillableAuthor(s *string) *JSONBookUpdateOne {
+	if s != nil {
+		jbuo.SetAuthor(*s)
+	}
+	return jbuo
+}
+
+// SetGenre sets the "genre" field.
+func (jbuo *JSONBookUpdateOne) SetGenre(s string) *JSONBookUpdateOne {
+	jbuo.mutation.SetGenre(s)
+	return jbuo
+}
+
+// SetNillableGenre sets the "genre" field if the given value is not nil.
+func (jbuo *JSONBookUpdateOne) SetNillableGenre(s *string) *JSONBookUpdateOne {
+	if s != nil {
+		jbuo.SetGenre(*s)
+	}
+	return jbuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (jbuo *JSONBookUpdateOne) SetQuantity(i int) *JSONBookUpdateOne {
+	jbuo.mutation.ResetQuantity()
+	jbuo.mutation.SetQuantity(i)
+	return jbuo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (jbuo *JSONBookUpdateOne) SetNillableQuantity(i *int) *JSONBookUpdateOne {
+	if i != nil {
+		jbuo.SetQuantity(*i)
+	}
+	return jbuo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (jbuo *JSONBookUpdateOne) AddQuantity(i int) *JSONBookUpdateOne {
+	jbuo.mutation.AddQuantity(i)
+	return jbuo
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (jbuo *JSONBookUpdateOne) SetPublicizedAt(t time.Time) *JSONBookUpdateOne {
+	jbuo.mutation.SetPublicizedAt(t)
+	return jbuo
+}
+
+// SetNillablePublicizedAt sets the "publicized_at" field if the given value is not nil.
+func (jbuo *JSONBookUpdateOne) SetNillablePublicizedAt(t *time.Time) *JSONBookUpdateOne {
+	if t != nil {
+		jbuo.SetPublicizedAt(*t)
+	}
+	return jbuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (jbuo *JSONBookUpdateOne) SetMetadata(mm model.BookMetadata) *JSONBookUpdateOne {
+	jbuo.mutation.SetMetadata(mm)
+	return jbuo
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (jbuo *JSONBookUpdateOne) SetNillableMetadata(mm *model.BookMetadata) *JSONBookUpdateOne {
+	if mm != nil {
+		jbuo.SetMetadata(*mm)
+	}
+	return jbuo
+}
+
+// Mutation returns the JSONBookMutation object of the builder.
+func (jbuo *JSONBookUpdateOne) Mutation() *JSONBookMutation {
+	return jbuo.mutation
+}
+
+// Where appends a list predicates to the JSONBookUpdate builder.
+func (jbuo *JSONBookUpdateOne) Where(ps ...predicate.JSONBook) *JSONBookUpdateOne {
+	jbuo.mutation.Where(ps...)
+	return jbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jbuo *JSONBookUpdateOne) Select(field string, fields ...string) *JSONBookUpdateOne {
+	jbuo.fields = append([]string{field}, fields...)
+	return jbuo
+}
+
+// Save executes the query and returns the updated JSONBook entity.
+func (jbuo *JSONBookUpdateOne) Save(ctx context.Context) (*JSONBook, error) {
+	return withHooks(ctx, jbuo.sqlSave, jbuo.mutation, jbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jbuo *JSONBookUpdateOne) SaveX(ctx context.Context) *JSONBook {
+	node, err := jbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jbuo *JSONBookUpdateOne) Exec(ctx context.Context) error {
+	_, err := jbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jbuo *JSONBookUpdateOne) ExecX(ctx context.Context) {
+	if err := jbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (jbuo *JSONBookUpdateOne) sqlSave(ctx context.Context) (_node *JSONBook, err error) {
+	_spec := sqlgraph.NewUpdateSpec(jsonbook.Table, jsonbook.Columns, sqlgraph.NewFieldSpec(jsonbook.FieldID, field.TypeUUID))
+	id, ok := jbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entuuid: missing "JSONBook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jsonbook.FieldID)
+		for _, f := range fields {
+			if !jsonbook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entuuid: invalid field %q for query", f)}
+			}
+			if f != jsonbook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jbuo.mutation.Isbn(); ok {
+		_spec.SetField(jsonbook.FieldIsbn, field.TypeString, value)
+	}
+	if value, ok := jbuo.mutation.Title(); ok {
+		_spec.SetField(jsonbook.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := jbuo.mutation.Author(); ok {
+		_spec.SetField(jsonbook.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := jbuo.mutation.Genre(); ok {
+		_spec.SetField(jsonbook.FieldGenre, field.TypeString, value)
+	}
+	if value, ok := jbuo.mutation.Quantity(); ok {
+		_spec.SetField(jsonbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := jbuo.mutation.AddedQuantity(); ok {
+		_spec.AddField(jsonbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := jbuo.mutation.PublicizedAt(); ok {
+		_spec.SetField(jsonbook.FieldPublicizedAt, field.TypeTime, value)
+	}
+	if value, ok := jbuo.mutation.Metadata(); ok {
+		_spec.SetField(jsonbook.FieldMetadata, field.TypeJSON, value)
+	}
+	_node = &JSONBook{config: jbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jsonbook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jbuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// BooksColumns holds the columns for the "books" table.
+	BooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "isbn", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "genre", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "publicized_at", Type: field.TypeTime},
+	}
+	// BooksTable holds the schema information for the "books" table.
+	BooksTable = &schema.Table{
+		Name:       "books",
+		Columns:    BooksColumns,
+		PrimaryKey: []*schema.Column{BooksColumns[0]},
+	}
+	// JSONBooksColumns holds the columns for the "json_books" table.
+	JSONBooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "isbn", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "genre", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "publicized_at", Type: field.TypeTime},
+		{Name: "metadata", Type: field.TypeJSON},
+	}
+	// JSONBooksTable holds the schema information for the "json_books" table.
+	JSONBooksTable = &schema.Table{
+		Name:       "json_books",
+		Columns:    JSONBooksColumns,
+		PrimaryKey: []*schema.Column{JSONBooksColumns[0]},
+	}
+	// NullableBooksColumns holds the columns for the "nullable_books" table.
+	NullableBooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "isbn", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "subtitle", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "author", Type: field.TypeString},
+		{Name: "genre", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "publicized_at", Type: field.TypeTime},
+		{Name: "discontinued_at", Type: field.TypeTime, Nullable: true},
+	}
+	// NullableBooksTable holds the schema information for the "nullable_books" table.
+	NullableBooksTable = &schema.Table{
+		Name:       "nullable_books",
+		Columns:    NullableBooksColumns,
+		PrimaryKey: []*schema.Column{NullableBooksColumns[0]},
+	}
+	// PricePoliciesColumns holds the columns for the "price_policies" table.
+	PricePoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "price", Type: field.TypeFloat64},
+		{Name: "start_date", Type: field.TypeTime},
+		{Name: "end_date", Type: field.TypeTime},
+		{Name: "book_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// PricePoliciesTable holds the schema information for the "price_policies" table.
+	PricePoliciesTable = &schema.Table{
+		Name:       "price_policies",
+		Columns:    PricePoliciesColumns,
+		PrimaryKey: []*schema.Column{PricePoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "price_policies_books_policies",
+				Columns:    []*schema.Column{PricePoliciesColumns[4]},
+				RefColumns: []*schema.Column{BooksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// TaggedBooksColumns holds the columns for the "tagged_books" table.
+	TaggedBooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "isbn", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "genre", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "publicized_at", Type: field.TypeTime},
+		{Name: "tags", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "text[]"}},
+	}
+	// TaggedBooksTable holds the schema information for the "tagged_books" table.
+	TaggedBooksTable = &schema.Table{
+		Name:       "tagged_books",
+		Columns:    TaggedBooksColumns,
+		PrimaryKey: []*schema.Column{TaggedBooksColumns[0]},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		BooksTable,
+		JSONBooksTable,
+		NullableBooksTable,
+		PricePoliciesTable,
+		TaggedBooksTable,
+	}
+)
+
+func init() {
+	PricePoliciesTable.ForeignKeys[0].RefTable = BooksTable
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/nullablebook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/predicate"
+	"github.com/google/uuid"
+)
+
+// NullableBookQuery is the builder for querying NullableBook entities.
+type NullableBookQuery struct {
+	config
+	ctx        *QueryContext
+	order      []nullablebook.OrderOption
+	inters     []Interceptor
+	predicates []predicate.NullableBook
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NullableBookQuery builder.
+func (nbq *NullableBookQuery) Where(ps ...predicate.NullableBook) *NullableBookQuery {
+	nbq.predicates = append(nbq.predicates, ps...)
+	return nbq
+}
+
+// Limit the number of records to be returned by this query.
+func (nbq *NullableBookQuery) Limit(limit int) *NullableBookQuery {
+	nbq.ctx.Limit = &limit
+	return nbq
+}
+
+// Offset to start from.
+func (nbq *NullableBookQuery) Offset(offset int) *NullableBookQuery {
+	nbq.ctx.Offset = &offset
+	return nbq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (nbq *NullableBookQuery) Unique(unique bool) *NullableBookQuery {
+	nbq.ctx.Unique = &unique
+	return nbq
+}
+
+// Order specifies how the records should be ordered.
+func (nbq *NullableBookQuery) Order(o ...nullablebook.OrderOption) *NullableBookQuery {
+	nbq.order = append(nbq.order, o...)
+	return nbq
+}
+
+// First returns the first NullableBook entity from the query.
+// Returns a *NotFoundError when no NullableBook was found.
+func (nbq *NullableBookQuery) First(ctx context.Context) (*NullableBook, error) {
+	nodes, err := nbq.Limit(1).All(setContextOp(ctx, nbq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{nullablebook.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nbq *NullableBookQuery) FirstX(ctx context.Context) *NullableBook {
+	node, err := nbq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NullableBook ID from the query.
+// Returns a *NotFoundError when no NullableBook ID was found.
+func (nbq *NullableBookQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = nbq.Limit(1).IDs(setContextOp(ctx, nbq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{nullablebook.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (nbq *NullableBookQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := nbq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NullableBook entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NullableBook entity is found.
+// Returns a *NotFoundError when no NullableBook entities are found.
+func (nbq *NullableBookQuery) Only(ctx context.Context) (*NullableBook, error) {
+	nodes, err := nbq.Limit(2).All(setContextOp(ctx, nbq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{nullablebook.Label}
+	default:
+		return nil, &NotSingularError{nullablebook.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nbq *NullableBookQuery) OnlyX(ctx context.Context) *NullableBook {
+	node, err := nbq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NullableBook ID in the query.
+// Returns a *NotSingularError when more than one NullableBook ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (nbq *NullableBookQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = nbq.Limit(2).IDs(setContextOp(ctx, nbq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{nullablebook.Label}
+	default:
+		err = &NotSingularError{nullablebook.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (nbq *NullableBookQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := nbq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NullableBooks.
+func (nbq *NullableBookQuery) All(ctx context.Context) ([]*NullableBook, error) {
+	ctx = setContextOp(ctx, nbq.ctx, "All")
+	if err := nbq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NullableBook, *NullableBookQuery]()
+	return withInterceptors[[]*NullableBook](ctx, nbq, qr, nbq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nbq *NullableBookQuery) AllX(ctx context.Context) []*NullableBook {
+	nodes, err := nbq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NullableBook IDs.
+func (nbq *NullableBookQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if nbq.ctx.Unique == nil && nbq.path != nil {
+		nbq.Unique(true)
+	}
+	ctx = setContextOp(ctx, nbq.ctx, "IDs")
+	if err = nbq.Select(nullablebook.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nbq *NullableBookQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := nbq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nbq *NullableBookQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, nbq.ctx, "Count")
+	if err := nbq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, nbq, querierCount[*NullableBookQuery](), nbq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nbq *NullableBookQuery) CountX(ctx context.Context) int {
+	count, err := nbq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nbq *NullableBookQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, nbq.ctx, "Exist")
+	switch _, err := nbq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("entuuid: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nbq *NullableBookQuery) ExistX(ctx context.Context) bool {
+	exist, err := nbq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NullableBookQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nbq *NullableBookQuery) Clone() *NullableBookQuery {
+	if nbq == nil {
+		return nil
+	}
+	return &NullableBookQuery{
+		config:     nbq.config,
+		ctx:        nbq.ctx.Clone(),
+		order:      append([]nullablebook.OrderOption{}, nbq.order...),
+		inters:     append([]Interceptor{}, nbq.inters...),
+		predicates: append([]predicate.NullableBook{}, nbq.predicates...),
+		// clone intermediate query.
+		sql:  nbq.sql.Clone(),
+		path: nbq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Isbn string `json:"isbn,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NullableBook.Query().
+//		GroupBy(nullablebook.FieldIsbn).
+//		Aggregate(entuuid.Count()).
+//		Scan(ctx, &v)
+func (nbq *NullableBookQuery) GroupBy(field string, fields ...string) *NullableBookGroupBy {
+	nbq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NullableBookGroupBy{build: nbq}
+	grbuild.flds = &nbq.ctx.Fields
+	grbuild.label = nullablebook.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Isbn string `json:"isbn,omitempty"`
+//	}
+//
+//	client.NullableBook.Query().
+//		Select(nullablebook.FieldIsbn).
+//		Scan(ctx, &v)
+func (nbq *NullableBookQuery) Select(fields ...string) *NullableBookSelect {
+	nbq.ctx.Fields = append(nbq.ctx.Fields, fields...)
+	sbuild := &NullableBookSelect{NullableBookQuery: nbq}
+	sbuild.label = nullablebook.Label
+	sbuild.flds, sbuild.scan = &nbq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NullableBookSelect configured with the given aggregations.
+func (nbq *NullableBookQuery) Aggregate(fns ...AggregateFunc) *NullableBookSelect {
+	return nbq.Select().Aggregate(fns...)
+}
+
+func (nbq *NullableBookQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range nbq.inters {
+		if inter == nil {
+			return fmt.Errorf("entuuid: uninitialized interceptor (forgotten import entuuid/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, nbq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range nbq.ctx.Fields {
+		if !nullablebook.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("entuuid: invalid field %q for query", f)}
+		}
+	}
+	if nbq.path != nil {
+		prev, err := nbq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nbq.sql = prev
+	}
+	return nil
+}
+
+func (nbq *NullableBookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NullableBook, error) {
+	var (
+		nodes = []*NullableBook{}
+		_spec = nbq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NullableBook).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NullableBook{config: nbq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nbq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (nbq *NullableBookQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := nbq.querySpec()
+	_spec.Node.Columns = nbq.ctx.Fields
+	if len(nbq.ctx.Fields) > 0 {
+		_spec.Unique = nbq.ctx.Unique != nil && *nbq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, nbq.driver, _spec)
+}
+
+func (nbq *NullableBookQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(nullablebook.Table, nullablebook.Columns, sqlgraph.NewFieldSpec(nullablebook.FieldID, field.TypeUUID))
+	_spec.From = nbq.sql
+	if unique := nbq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if nbq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := nbq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, nullablebook.FieldID)
+		for i := range fields {
+			if fields[i] != nullablebook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := nbq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nbq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nbq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nbq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nbq *NullableBookQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(nbq.driver.Dialect())
+	t1 := builder.Table(nullablebook.Table)
+	columns := nbq.ctx.Fields
+	if len(columns) == 0 {
+		columns = nullablebook.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if nbq.sql != nil {
+		selector = nbq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if nbq.ctx.Unique != nil && *nbq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range nbq.predicates {
+		p(selector)
+	}
+	for _, p := range nbq.order {
+		p(selector)
+	}
+	if offset := nbq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nbq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NullableBookGroupBy is the group-by builder for NullableBook entities.
+type NullableBookGroupBy struct {
+	selector
+	build *NullableBookQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (nbgb *NullableBookGroupBy) Aggregate(fns ...AggregateFunc) *NullableBookGroupBy {
+	nbgb.fns = append(nbgb.fns, fns...)
+	return nbgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nbgb *NullableBookGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nbgb.build.ctx, "GroupBy")
+	if err := nbgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NullableBookQuery, *NullableBookGroupBy](ctx, nbgb.build, nbgb, nbgb.build.inters, v)
+}
+
+func (nbgb *NullableBookGroupBy) sqlScan(ctx context.Context, root *NullableBookQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(nbgb.fns))
+	for _, fn := range nbgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*nbgb.flds)+len(nbgb.fns))
+		for _, f := range *nbgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*nbgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nbgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NullableBookSelect is the builder for selecting fields of NullableBook entities.
+type NullableBookSelect struct {
+	*NullableBookQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nbs *NullableBookSelect) Aggregate(fns ...AggregateFunc) *NullableBookSelect {
+	nbs.fns = append(nbs.fns, fns...)
+	return nbs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nbs *NullableBookSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nbs.ctx, "Select")
+	if err := nbs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NullableBookQuery, *NullableBookSelect](ctx, nbs.NullableBookQuery, nbs, nbs.inters, v)
+}
+
+func (nbs *NullableBookSelect) sqlScan(ctx context.Context, root *NullableBookQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nbs.fns))
+	for _, fn := range nbs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nbs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nbs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
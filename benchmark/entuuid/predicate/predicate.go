@@ -0,0 +1,22 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// Book is the predicate function for book builders.
+type Book func(*sql.Selector)
+
+// JSONBook is the predicate function for jsonbook builders.
+type JSONBook func(*sql.Selector)
+
+// NullableBook is the predicate function for nullablebook builders.
+type NullableBook func(*sql.Selector)
+
+// PricePolicy is the predicate function for pricepolicy builders.
+type PricePolicy func(*sql.Selector)
+
+// TaggedBook is the predicate function for taggedbook builders.
+type TaggedBook func(*sql.Selector)
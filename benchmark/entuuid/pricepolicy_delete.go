@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/predicate"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/pricepolicy"
+)
+
+// PricePolicyDelete is the builder for deleting a PricePolicy entity.
+type PricePolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *PricePolicyMutation
+}
+
+// Where appends a list predicates to the PricePolicyDelete builder.
+func (ppd *PricePolicyDelete) Where(ps ...predicate.PricePolicy) *PricePolicyDelete {
+	ppd.mutation.Where(ps...)
+	return ppd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ppd *PricePolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ppd.sqlExec, ppd.mutation, ppd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppd *PricePolicyDelete) ExecX(ctx context.Context) int {
+	n, err := ppd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ppd *PricePolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pricepolicy.Table, sqlgraph.NewFieldSpec(pricepolicy.FieldID, field.TypeUUID))
+	if ps := ppd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ppd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ppd.mutation.done = true
+	return affected, err
+}
+
+// PricePolicyDeleteOne is the builder for deleting a single PricePolicy entity.
+type PricePolicyDeleteOne struct {
+	ppd *PricePolicyDelete
+}
+
+// Where appends a list predicates to the PricePolicyDelete builder.
+func (ppdo *PricePolicyDeleteOne) Where(ps ...predicate.PricePolicy) *PricePolicyDeleteOne {
+	ppdo.ppd.mutation.Where(ps...)
+	return ppdo
+}
+
+// Exec executes the deletion query.
+func (ppdo *PricePolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := ppdo.ppd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pricepolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ppdo *PricePolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := ppdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
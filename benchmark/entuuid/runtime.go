@@ -0,0 +1,9 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+}
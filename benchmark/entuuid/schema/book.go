@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Book holds the schema definition for the Book entity of the UUIDv7 key variant. Its id has no
+// default, as the database generates it.
+type Book struct {
+	ent.Schema
+}
+
+// Fields of the Book.
+func (Book) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}),
+		field.String("isbn"),
+		field.String("title"),
+		field.String("author"),
+		field.String("genre"),
+		field.Int("quantity"),
+		field.Time("publicized_at"),
+	}
+}
+
+// Edges of the Book.
+func (Book) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("policies", PricePolicy.Type),
+	}
+}
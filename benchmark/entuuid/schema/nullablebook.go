@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NullableBook holds the schema definition for the NullableBook entity, the book of the schema
+// variant with nullable columns, keyed by UUIDv7 like Book.
+type NullableBook struct {
+	ent.Schema
+}
+
+// Fields of the NullableBook.
+func (NullableBook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}),
+		field.String("isbn"),
+		field.String("title"),
+		field.String("subtitle").Optional().Nillable(),
+		field.Text("description").Optional().Nillable(),
+		field.String("author"),
+		field.String("genre"),
+		field.Int("quantity"),
+		field.Time("publicized_at"),
+		field.Time("discontinued_at").Optional().Nillable(),
+	}
+}
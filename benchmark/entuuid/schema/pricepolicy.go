@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PricePolicy holds the schema definition for the PricePolicy entity of the UUIDv7 key variant.
+type PricePolicy struct {
+	ent.Schema
+}
+
+// Fields of the PricePolicy.
+func (PricePolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}),
+		field.UUID("book_id", uuid.UUID{}).Optional(),
+		field.Float("price"),
+		field.Time("start_date"),
+		field.Time("end_date"),
+	}
+}
+
+// Edges of the PricePolicy.
+func (PricePolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("book", Book.Type).
+			Ref("policies").
+			Field("book_id").
+			Unique(),
+	}
+}
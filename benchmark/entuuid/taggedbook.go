@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/taggedbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+	"github.com/google/uuid"
+)
+
+// TaggedBook is the model entity for the TaggedBook schema.
+type TaggedBook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Isbn holds the value of the "isbn" field.
+	Isbn string `json:"isbn,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// Genre holds the value of the "genre" field.
+	Genre string `json:"genre,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// PublicizedAt holds the value of the "publicized_at" field.
+	PublicizedAt time.Time `json:"publicized_at,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags         model.TextArray `json:"tags,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaggedBook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taggedbook.FieldTags:
+			values[i] = new(model.TextArray)
+		case taggedbook.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case taggedbook.FieldIsbn, taggedbook.FieldTitle, taggedbook.FieldAuthor, taggedbook.FieldGenre:
+			values[i] = new(sql.NullString)
+		case taggedbook.FieldPublicizedAt:
+			values[i] = new(sql.NullTime)
+		case taggedbook.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaggedBook fields.
+func (tb *TaggedBook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taggedbook.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tb.ID = *value
+			}
+		case taggedbook.FieldIsbn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field isbn", values[i])
+			} else if value.Valid {
+				tb.Isbn = value.String
+			}
+		case taggedbook.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				tb.Title = value.String
+			}
+		case taggedbook.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				tb.Author = value.String
+			}
+		case taggedbook.FieldGenre:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field genre", values[i])
+			} else if value.Valid {
+				tb.Genre = value.String
+			}
+		case taggedbook.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				tb.Quantity = int(value.Int64)
+			}
+		case taggedbook.FieldPublicizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field publicized_at", values[i])
+			} else if value.Valid {
+				tb.PublicizedAt = value.Time
+			}
+		case taggedbook.FieldTags:
+			if value, ok := values[i].(*model.TextArray); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil {
+				tb.Tags = *value
+			}
+		default:
+			tb.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaggedBook.
+// This includes values selected through modifiers, order, etc.
+func (tb *TaggedBook) Value(name string) (ent.Value, error) {
+	return tb.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TaggedBook.
+// Note that you need to call TaggedBook.Unwrap() before calling this method if this TaggedBook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tb *TaggedBook) Update() *TaggedBookUpdateOne {
+	return NewTaggedBookClient(tb.config).UpdateOne(tb)
+}
+
+// Unwrap unwraps the TaggedBook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tb *TaggedBook) Unwrap() *TaggedBook {
+	_tx, ok := tb.config.driver.(*txDriver)
+	if !ok {
+		panic("entuuid: TaggedBook is not a transactional entity")
+	}
+	tb.config.driver = _tx.drv
+	return tb
+}
+
+// String implements the fmt.Stringer.
+func (tb *TaggedBook) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaggedBook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tb.ID))
+	builder.WriteString("isbn=")
+	builder.WriteString(tb.Isbn)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(tb.Title)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(tb.Author)
+	builder.WriteString(", ")
+	builder.WriteString("genre=")
+	builder.WriteString(tb.Genre)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", tb.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("publicized_at=")
+	builder.WriteString(tb.PublicizedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", tb.Tags))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaggedBooks is a parsable slice of TaggedBook.
+type TaggedBooks []*TaggedBook
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/predicate"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/taggedbook"
+)
+
+// TaggedBookDelete is the builder for deleting a TaggedBook entity.
+type TaggedBookDelete struct {
+	config
+	hooks    []Hook
+	mutation *TaggedBookMutation
+}
+
+// Where appends a list predicates to the TaggedBookDelete builder.
+func (tbd *TaggedBookDelete) Where(ps ...predicate.TaggedBook) *TaggedBookDelete {
+	tbd.mutation.Where(ps...)
+	return tbd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tbd *TaggedBookDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tbd.sqlExec, tbd.mutation, tbd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbd *TaggedBookDelete) ExecX(ctx context.Context) int {
+	n, err := tbd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tbd *TaggedBookDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(taggedbook.Table, sqlgraph.NewFieldSpec(taggedbook.FieldID, field.TypeUUID))
+	if ps := tbd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tbd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tbd.mutation.done = true
+	return affected, err
+}
+
+// TaggedBookDeleteOne is the builder for deleting a single TaggedBook entity.
+type TaggedBookDeleteOne struct {
+	tbd *TaggedBookDelete
+}
+
+// Where appends a list predicates to the TaggedBookDelete builder.
+func (tbdo *TaggedBookDeleteOne) Where(ps ...predicate.TaggedBook) *TaggedBookDeleteOne {
+	tbdo.tbd.mutation.Where(ps...)
+	return tbdo
+}
+
+// Exec executes the deletion query.
+func (tbdo *TaggedBookDeleteOne) Exec(ctx context.Context) error {
+	n, err := tbdo.tbd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{taggedbook.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbdo *TaggedBookDeleteOne) ExecX(ctx context.Context) {
+	if err := tbdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entuuid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/predicate"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/entuuid/taggedbook"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// TaggedBookUpdate is the builder for updating TaggedBook entities.
+type TaggedBookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaggedBookMutation
+}
+
+// Where appends a list predicates to the TaggedBookUpdate builder.
+func (tbu *TaggedBookUpdate) Where(ps ...predicate.TaggedBook) *TaggedBookUpdate {
+	tbu.mutation.Where(ps...)
+	return tbu
+}
+
+// SetIsbn sets the "isbn" field.
+func (tbu *TaggedBookUpdate) SetIsbn(s string) *TaggedBookUpdate {
+	tbu.mutation.SetIsbn(s)
+	return tbu
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (tbu *TaggedBookUpdate) SetNillableIsbn(s *string) *TaggedBookUpdate {
+	if s != nil {
+		tbu.SetIsbn(*s)
+	}
+	return tbu
+}
+
+// SetTitle sets the "title" field.
+func (tbu *TaggedBookUpdate) SetTitle(s string) *TaggedBookUpdate {
+	tbu.mutation.SetTitle(s)
+	return tbu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tbu *TaggedBookUpdate) SetNillableTitle(s *string) *TaggedBookUpdate {
+	if s != nil {
+		tbu.SetTitle(*s)
+	}
+	return tbu
+}
+
+// SetAuthor sets the "author" field.
+func (tbu *TaggedBookUpdate) SetAuthor(s string) *TaggedBookUpdate {
+	tbu.mutation.SetAuthor(s)
+	return tbu
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (tbu *TaggedBookUpdate) SetNillableAuthor(s *string) *TaggedBookUpdate {
+	if s != nil {
+		tbu.SetAuthor(*s)
+	}
+	return tbu
+}
+
+// SetGenre sets the "genre" field.
+func (tbu *TaggedBookUpdate) SetGenre(s string) *TaggedBookUpdate {
+	tbu.mutation.SetGenre(s)
+	return tbu
+}
+
+// SetNillableGenre sets the "genre" field if the given value is not nil.
+func (tbu *TaggedBookUpdate) SetNillableGenre(s *string) *TaggedBookUpdate {
+	if s != nil {
+		tbu.SetGenre(*s)
+	}
+	return tbu
+}
+
+// SetQuantity sets the "quantity" field.
+func (tbu *TaggedBookUpdate) SetQuantity(i int) *TaggedBookUpdate {
+	tbu.mutation.ResetQuantity()
+	tbu.mutation.SetQuantity(i)
+	return tbu
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (tbu *TaggedBookUpdate) SetNillableQuantity(i *int) *TaggedBookUpdate {
+	if i != nil {
+		tbu.SetQuantity(*i)
+	}
+	return tbu
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (tbu *TaggedBookUpdate) AddQuantity(i int) *TaggedBookUpdate {
+	tbu.mutation.AddQuantity(i)
+	return tbu
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (tbu *TaggedBookUpdate) SetPublicizedAt(t time.Time) *TaggedBookUpdate {
+	tbu.mutation.SetPublicizedAt(t)
+	return tbu
+}
+
+// SetNillablePublicizedAt sets the "publicized_at" field if the given value is not nil.
+func (tbu *TaggedBookUpdate) SetNillablePublicizedAt(t *time.Time) *TaggedBookUpdate {
+	if t != nil {
+		tbu.SetPublicizedAt(*t)
+	}
+	return tbu
+}
+
+// SetTags sets the "tags" field.
+func (tbu *TaggedBookUpdate) SetTags(ma model.TextArray) *TaggedBookUpdate {
+	tbu.mutation.SetTags(ma)
+	return tbu
+}
+
+// Mutation returns the TaggedBookMutation object of the builder.
+func (tbu *TaggedBookUpdate) Mutation() *TaggedBookMutation {
+	return tbu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tbu *TaggedBookUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tbu.sqlSave, tbu.mutation, tbu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tbu *TaggedBookUpdate) SaveX(ctx context.Context) int {
+	affected, err := tbu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tbu *TaggedBookUpdate) Exec(ctx context.Context) error {
+	_, err := tbu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbu *TaggedBookUpdate) ExecX(ctx context.Context) {
+	if err := tbu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tbu *TaggedBookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(taggedbook.Table, taggedbook.Columns, sqlgraph.NewFieldSpec(taggedbook.FieldID, field.TypeUUID))
+	if ps := tbu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tbu.mutation.Isbn(); ok {
+		_spec.SetField(taggedbook.FieldIsbn, field.TypeString, value)
+	}
+	if value, ok := tbu.mutation.Title(); ok {
+		_spec.SetField(taggedbook.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tbu.mutation.Author(); ok {
+		_spec.SetField(taggedbook.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := tbu.mutation.Genre(); ok {
+		_spec.SetField(taggedbook.FieldGenre, field.TypeString, value)
+	}
+	if value, ok := tbu.mutation.Quantity(); ok {
+		_spec.SetField(taggedbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := tbu.mutation.AddedQuantity(); ok {
+		_spec.AddField(taggedbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := tbu.mutation.PublicizedAt(); ok {
+		_spec.SetField(taggedbook.FieldPublicizedAt, field.TypeTime, value)
+	}
+	if value, ok := tbu.mutation.Tags(); ok {
+		_spec.SetField(taggedbook.FieldTags, field.TypeOther, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tbu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taggedbook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tbu.mutation.done = true
+	return n, nil
+}
+
+// TaggedBookUpdateOne is the builder for updating a single TaggedBook entity.
+type TaggedBookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaggedBookMutation
+}
+
+// SetIsbn sets the "isbn" field.
+func (tbuo *TaggedBookUpdateOne) SetIsbn(s string) *TaggedBookUpdateOne {
+	tbuo.mutation.SetIsbn(s)
+	return tbuo
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (tbuo *TaggedBookUpdateOne) SetNillableIsbn(s *string) *TaggedBookUpdateOne {
+	if s != nil {
+		tbuo.SetIsbn(*s)
+	}
+	return tbuo
+}
+
+// SetTitle sets the "title" field.
+func (tbuo *TaggedBookUpdateOne) SetTitle(s string) *TaggedBookUpdateOne {
+	tbuo.mutation.SetTitle(s)
+	return tbuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (tbuo *TaggedBookUpdateOne) SetNillableTitle(s *string) *TaggedBookUpdateOne {
+	if s != nil {
+		tbuo.SetTitle(*s)
+	}
+	return tbuo
+}
+
+// SetAuthor sets the "author" field.
+func (tbuo *TaggedBookUpdateOne) SetAuthor(s string) *TaggedBookUpdateOne {
+	tbuo.mutation.SetAuthor(s)
+	return tbuo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (tbuo *TaggedBookUpdateOne) SetNillableAuthor(s *string) *TaggedBookUpdateOne {
+	if s != nil {
+		tbuo.SetAuthor(*s)
+	}
+	return tbuo
+}
+
+// SetGenre sets the "genre" field.
+func (tbuo *TaggedBookUpdateOne) SetGenre(s string) *TaggedBookUpdateOne {
+	tbuo.mutation.SetGenre(s)
+	return tbuo
+}
+
+// SetNillableGenre sets the "genre" field if the given value is not nil.
+func (tbuo *TaggedBookUpdateOne) SetNillableGenre(s *string) *TaggedBookUpdateOne {
+	if s != nil {
+		tbuo.SetGenre(*s)
+	}
+	return tbuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (tbuo *TaggedBookUpdateOne) SetQuantity(i int) *TaggedBookUpdateOne {
+	tbuo.mutation.ResetQuantity()
+	tbuo.mutation.SetQuantity(i)
+	return tbuo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (tbuo *TaggedBookUpdateOne) SetNillableQuantity(i *int) *TaggedBookUpdateOne {
+	if i != nil {
+		tbuo.SetQuantity(*i)
+	}
+	return tbuo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (tbuo *TaggedBookUpdateOne) AddQuantity(i int) *TaggedBookUpdateOne {
+	tbuo.mutation.AddQuantity(i)
+	return tbuo
+}
+
+// SetPublicizedAt sets the "publicized_at" field.
+func (tbuo *TaggedBookUpdateOne) SetPublicizedAt(t time.Time) *TaggedBookUpdateOne {
+	tbuo.mutation.SetPublicizedAt(t)
+	return tbuo
+}
+
+// SetNillablePublicizedAt sets the "publicized_at" field if the given value is not nil.
+func (tbuo *TaggedBookUpdateOne) SetNillablePublicizedAt(t *time.Time) *TaggedBookUpdateOne {
+	if t != nil {
+		tbuo.SetPublicizedAt(*t)
+	}
+	return tbuo
+}
+
+// SetTags sets the "tags" field.
+func (tbuo *TaggedBookUpdateOne) SetTags(ma model.TextArray) *TaggedBookUpdateOne {
+	tbuo.mutation.SetTags(ma)
+	return tbuo
+}
+
+// Mutation returns the TaggedBookMutation object of the builder.
+func (tbuo *TaggedBookUpdateOne) Mutation() *TaggedBookMutation {
+	return tbuo.mutation
+}
+
+// Where appends a list predicates to the TaggedBookUpdate builder.
+func (tbuo *TaggedBookUpdateOne) Where(ps ...predicate.TaggedBook) *TaggedBookUpdateOne {
+	tbuo.mutation.Where(ps...)
+	return tbuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tbuo *TaggedBookUpdateOne) Select(field string, fields ...string) *TaggedBookUpdateOne {
+	tbuo.fields = append([]string{field}, fields...)
+	return tbuo
+}
+
+// Save executes the query and returns the updated TaggedBook entity.
+func (tbuo *TaggedBookUpdateOne) Save(ctx context.Context) (*TaggedBook, error) {
+	return withHooks(ctx, tbuo.sqlSave, tbuo.mutation, tbuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tbuo *TaggedBookUpdateOne) SaveX(ctx context.Context) *TaggedBook {
+	node, err := tbuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tbuo *TaggedBookUpdateOne) Exec(ctx context.Context) error {
+	_, err := tbuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tbuo *TaggedBookUpdateOne) ExecX(ctx context.Context) {
+	if err := tbuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tbuo *TaggedBookUpdateOne) sqlSave(ctx context.Context) (_node *TaggedBook, err error) {
+	_spec := sqlgraph.NewUpdateSpec(taggedbook.Table, taggedbook.Columns, sqlgraph.NewFieldSpec(taggedbook.FieldID, field.TypeUUID))
+	id, ok := tbuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`entuuid: missing "TaggedBook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tbuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taggedbook.FieldID)
+		for _, f := range fields {
+			if !taggedbook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("entuuid: invalid field %q for query", f)}
+			}
+			if f != taggedbook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tbuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tbuo.mutation.Isbn(); ok {
+		_spec.SetField(taggedbook.FieldIsbn, field.TypeString, value)
+	}
+	if value, ok := tbuo.mutation.Title(); ok {
+		_spec.SetField(taggedbook.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := tbuo.mutation.Author(); ok {
+		_spec.SetField(taggedbook.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := tbuo.mutation.Genre(); ok {
+		_spec.SetField(taggedbook.FieldGenre, field.TypeString, value)
+	}
+	if value, ok := tbuo.mutation.Quantity(); ok {
+		_spec.SetField(taggedbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := tbuo.mutation.AddedQuantity(); ok {
+		_spec.AddField(taggedbook.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := tbuo.mutation.PublicizedAt(); ok {
+		_spec.SetField(taggedbook.FieldPublicizedAt, field.TypeTime, value)
+	}
+	if value, ok := tbuo.mutation.Tags(); ok {
+		_spec.SetField(taggedbook.FieldTags, field.TypeOther, value)
+	}
+	_node = &TaggedBook{config: tbuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tbuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taggedbook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tbuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,205 @@
+// Package environment fingerprints the machine, database and dependencies a benchmark ran with.
+package environment
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/sqlc"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+)
+
+// sqlcModule names the sqlc entry of Dependencies, which isn't a Go module.
+const sqlcModule = "sqlc"
+
+// modules are the benchmarked libraries whose versions are recorded.
+var modules = []string{
+	"gorm.io/gorm",
+	"gorm.io/driver/postgres",
+	"github.com/uptrace/bun",
+	"github.com/uptrace/bun/driver/pgdriver",
+	"entgo.io/ent",
+	"github.com/jackc/pgx/v5",
+}
+
+// Environment describes where a benchmark execution ran. Values that couldn't be detected are empty.
+type Environment struct {
+	GoVersion  string `json:"go_version"`
+	GOOS       string `json:"goos"`
+	GOARCH     string `json:"goarch"`
+	GOMAXPROCS int    `json:"gomaxprocs"`
+	CPU        string `json:"cpu"`
+	CPUCores   int    `json:"cpu_cores"`
+	// MemoryBytes is the total physical memory of the machine.
+	MemoryBytes     uint64 `json:"memory_bytes"`
+	PostgresVersion string `json:"postgres_version"`
+	// Dependencies maps the module path of every benchmarked library to its version.
+	Dependencies map[string]string `json:"dependencies"`
+	Commit       string            `json:"commit"`
+	// Modified is set when the repository had uncommitted changes.
+	Modified bool `json:"modified"`
+}
+
+// Capture detects the environment of the current process, querying the database for its version.
+func Capture(ctx context.Context) Environment {
+	env := Environment{
+		GoVersion:    runtime.Version(),
+		GOOS:         runtime.GOOS,
+		GOARCH:       runtime.GOARCH,
+		GOMAXPROCS:   runtime.GOMAXPROCS(0),
+		CPU:          cpuModel(),
+		CPUCores:     runtime.NumCPU(),
+		MemoryBytes:  totalMemory(),
+		Dependencies: make(map[string]string, len(modules)+1),
+	}
+	env.PostgresVersion, _ = postgresVersion(ctx)
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if slices.Contains(modules, dep.Path) {
+				env.Dependencies[dep.Path] = dep.Version
+			}
+		}
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				env.Commit = setting.Value
+			case "vcs.modified":
+				env.Modified = setting.Value == "true"
+			}
+		}
+	}
+	env.Dependencies[sqlcModule] = sqlc.Version()
+
+	// go run doesn't stamp the build with the VCS information.
+	if env.Commit == "" {
+		env.Commit, env.Modified = gitCommit()
+	}
+	return env
+}
+
+// Pairs lists the environment as key and value pairs, in a stable order, the dependencies last.
+func (e Environment) Pairs() [][2]string {
+	commit := e.Commit
+	if e.Modified && commit != "" {
+		commit += "-dirty"
+	}
+	pairs := [][2]string{
+		{"go-version", e.GoVersion},
+		{"goos", e.GOOS},
+		{"goarch", e.GOARCH},
+		{"gomaxprocs", strconv.Itoa(e.GOMAXPROCS)},
+		{"cpu", e.CPU},
+		{"cpu-cores", strconv.Itoa(e.CPUCores)},
+		{"memory", formatBytes(e.MemoryBytes)},
+		{"postgres", e.PostgresVersion},
+		{"commit", commit},
+	}
+	for _, module := range append(slices.Clone(modules), sqlcModule) {
+		if version, ok := e.Dependencies[module]; ok {
+			pairs = append(pairs, [2]string{module, version})
+		}
+	}
+	for i := range pairs {
+		if pairs[i][1] == "" {
+			pairs[i][1] = "unknown"
+		}
+	}
+	return pairs
+}
+
+func postgresVersion(ctx context.Context) (string, error) {
+	conn, err := pgx.Connect(ctx, utils.PostgresDSN)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	var version string
+	err = conn.QueryRow(ctx, "SHOW server_version").Scan(&version)
+	return version, err
+}
+
+func cpuModel() string {
+	switch runtime.GOOS {
+	case "linux":
+		// x86 reports the model name, while some ARM kernels only report the processor.
+		for _, key := range []string{"model name", "Processor", "Hardware"} {
+			if value := procField("/proc/cpuinfo", key); value != "" {
+				return value
+			}
+		}
+	case "darwin":
+		return sysctl("machdep.cpu.brand_string")
+	}
+	return ""
+}
+
+func totalMemory() uint64 {
+	switch runtime.GOOS {
+	case "linux":
+		// MemTotal is reported in kB.
+		kb, _ := strconv.ParseUint(strings.TrimSuffix(procField("/proc/meminfo", "MemTotal"), " kB"), 10, 64)
+		return kb * 1024
+	case "darwin":
+		bytes, _ := strconv.ParseUint(sysctl("hw.memsize"), 10, 64)
+		return bytes
+	}
+	return 0
+}
+
+// procField returns the value of the first "key: value" line of a /proc file with the given key.
+func procField(path, key string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		name, value, ok := strings.Cut(scanner.Text(), ":")
+		if ok && strings.TrimSpace(name) == key {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+func sysctl(name string) string {
+	out, err := exec.Command("sysctl", "-n", name).Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func gitCommit() (string, bool) {
+	out, err := exec.Command("git", "rev-parse", "HEAD").Output()
+	if err != nil {
+		return "", false
+	}
+	status, err := exec.Command("git", "status", "--porcelain").Output()
+	return strings.TrimSpace(string(out)), err == nil && len(strings.TrimSpace(string(status))) > 0
+}
+
+func formatBytes(bytes uint64) string {
+	if bytes == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.1f GiB", float64(bytes)/(1<<30))
+}
@@ -0,0 +1,399 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/environment"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/histogram"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/stats"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+)
+
+// SchemaVersion is bumped on every incompatible change of the JSON document.
+const SchemaVersion = 1
+
+const (
+	JSON     = "json"
+	CSV      = "csv"
+	Markdown = "markdown"
+	Benchfmt = "benchfmt"
+)
+
+// Formats lists every machine-readable format supported by Write.
+var Formats = []string{JSON, CSV, Markdown, Benchfmt}
+
+// Document is the versioned JSON representation of a benchmark execution.
+type Document struct {
+	SchemaVersion int                     `json:"schema_version"`
+	Config        utils.Config            `json:"config"`
+	Environment   environment.Environment `json:"environment"`
+	Results       []Result                `json:"results"`
+}
+
+// Result mirrors benchmark.ResultWrapper.
+type Result struct {
+	Orm        string                          `json:"orm"`
+	Benchmarks map[string]BenchmarkResult      `json:"benchmarks"`
+	Samples    map[string][]BenchmarkResult    `json:"samples,omitempty"`
+	Summaries  map[string]stats.Summary        `json:"summaries,omitempty"`
+	Latencies  map[string]*histogram.Histogram `json:"latencies,omitempty"`
+	Err        string                          `json:"error,omitempty"`
+}
+
+// BenchmarkResult mirrors testing.BenchmarkResult, along with its per-operation values.
+type BenchmarkResult struct {
+	N                 int                `json:"n"`
+	T                 time.Duration      `json:"t_ns"`
+	Bytes             int64              `json:"bytes"`
+	MemAllocs         uint64             `json:"mem_allocs"`
+	MemBytes          uint64             `json:"mem_bytes"`
+	Extra             map[string]float64 `json:"extra,omitempty"`
+	NsPerOp           int64              `json:"ns_per_op"`
+	AllocedBytesPerOp int64              `json:"alloced_bytes_per_op"`
+	AllocsPerOp       int64              `json:"allocs_per_op"`
+}
+
+// NewDocument converts the results into their versioned JSON representation.
+func NewDocument(config utils.Config, env environment.Environment, results []benchmark.ResultWrapper) Document {
+	doc := Document{SchemaVersion: SchemaVersion, Config: config, Environment: env}
+	for _, r := range sortByOrm(results) {
+		result := Result{
+			Orm:        r.Orm,
+			Benchmarks: make(map[string]BenchmarkResult, len(r.Benchmarks)),
+			Samples:    make(map[string][]BenchmarkResult, len(r.Samples)),
+			Summaries:  make(map[string]stats.Summary, len(r.Benchmarks)),
+			Latencies:  r.Latencies,
+		}
+		for op, b := range r.Benchmarks {
+			result.Benchmarks[op] = newBenchmarkResult(b)
+			result.Summaries[op] = r.Summary(op)
+		}
+		for op, samples := range r.Samples {
+			for _, b := range samples {
+				result.Samples[op] = append(result.Samples[op], newBenchmarkResult(b))
+			}
+		}
+		if r.Err != nil {
+			result.Err = r.Err.Error()
+		}
+		doc.Results = append(doc.Results, result)
+	}
+	return doc
+}
+
+// Wrappers converts the document back into the results it was built from.
+func (d Document) Wrappers() []benchmark.ResultWrapper {
+	wrappers := make([]benchmark.ResultWrapper, 0, len(d.Results))
+	for _, r := range d.Results {
+		wrapper := benchmark.ResultWrapper{
+			Orm:        r.Orm,
+			Benchmarks: make(map[string]testing.BenchmarkResult, len(r.Benchmarks)),
+			Samples:    make(map[string][]testing.BenchmarkResult, len(r.Samples)),
+			Latencies:  r.Latencies,
+		}
+		for op, b := range r.Benchmarks {
+			wrapper.Benchmarks[op] = b.benchmarkResult()
+		}
+		for op, samples := range r.Samples {
+			for _, b := range samples {
+				wrapper.Samples[op] = append(wrapper.Samples[op], b.benchmarkResult())
+			}
+		}
+		if r.Err != "" {
+			wrapper.Err = errors.New(r.Err)
+		}
+		wrappers = append(wrappers, wrapper)
+	}
+	return wrappers
+}
+
+func newBenchmarkResult(b testing.BenchmarkResult) BenchmarkResult {
+	return BenchmarkResult{
+		N:                 b.N,
+		T:                 b.T,
+		Bytes:             b.Bytes,
+		MemAllocs:         b.MemAllocs,
+		MemBytes:          b.MemBytes,
+		Extra:             b.Extra,
+		NsPerOp:           b.NsPerOp(),
+		AllocedBytesPerOp: b.AllocedBytesPerOp(),
+		AllocsPerOp:       b.AllocsPerOp(),
+	}
+}
+
+func (b BenchmarkResult) benchmarkResult() testing.BenchmarkResult {
+	return testing.BenchmarkResult{
+		N:         b.N,
+		T:         b.T,
+		Bytes:     b.Bytes,
+		MemAllocs: b.MemAllocs,
+		MemBytes:  b.MemBytes,
+		Extra:     b.Extra,
+	}
+}
+
+// ReadDocument loads a JSON document previously written by Write.
+func ReadDocument(path string) (Document, error) {
+	var doc Document
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return doc, err
+	}
+	if err = json.Unmarshal(data, &doc); err != nil {
+		return doc, err
+	}
+	if doc.SchemaVersion != SchemaVersion {
+		return doc, fmt.Errorf("unsupported schema version %d, expected %d", doc.SchemaVersion, SchemaVersion)
+	}
+	return doc, nil
+}
+
+// Write encodes the results into w using one of the Formats. The effective configuration and
+// the environment are echoed in every format, so the execution can be reproduced.
+func Write(w io.Writer, format string, config utils.Config, env environment.Environment, results []benchmark.ResultWrapper) error {
+	switch format {
+	case JSON:
+		return writeJSON(w, config, env, results)
+	case CSV:
+		return writeCSV(w, config, env, results)
+	case Markdown:
+		return writeMarkdown(w, config, env, results)
+	case Benchfmt:
+		return writeBenchfmt(w, config, env, results)
+	default:
+		return fmt.Errorf("unknown format %q, valid ones are: %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+func writeJSON(w io.Writer, config utils.Config, env environment.Environment, results []benchmark.ResultWrapper) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(NewDocument(config, env, results))
+}
+
+var csvHeader = []string{
+	"orm", "operation", "n", "ns_per_op", "bytes_per_op", "allocs_per_op", "ops_per_sec", "latency_ns_per_op",
+	"p50_ns", "p90_ns", "p99_ns", "p99_9_ns", "max_ns", "peak_heap_bytes", "runs", "mean_ns_per_op", "median_ns_per_op",
+	"stddev_ns_per_op", "cv", "ci95_low_ns_per_op", "ci95_high_ns_per_op", "error",
+}
+
+// writeCSV repeats the configuration and the environment on every row, after the result columns.
+func writeCSV(w io.Writer, config utils.Config, env environment.Environment, results []benchmark.ResultWrapper) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeaderFor(config, env)); err != nil {
+		return err
+	}
+	if err := writeCSVRows(writer, config, env, results); err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func csvHeaderFor(config utils.Config, env environment.Environment) []string {
+	header := slices.Clone(csvHeader)
+	for _, pair := range append(config.Pairs(), env.Pairs()...) {
+		header = append(header, strings.ReplaceAll(pair[0], "-", "_"))
+	}
+	return header
+}
+
+func writeCSVRows(writer *csv.Writer, config utils.Config, env environment.Environment, results []benchmark.ResultWrapper) error {
+	var settings []string
+	for _, pair := range append(config.Pairs(), env.Pairs()...) {
+		settings = append(settings, pair[1])
+	}
+	for _, r := range sortByOrm(results) {
+		var errMessage string
+		if r.Err != nil {
+			errMessage = r.Err.Error()
+		}
+		if len(r.Benchmarks) == 0 {
+			row := make([]string, len(csvHeader))
+			row[0], row[len(row)-1] = r.Orm, errMessage
+			if err := writer.Write(append(row, settings...)); err != nil {
+				return err
+			}
+		}
+		for _, op := range sortedOperations(r.Benchmarks) {
+			result := r.Benchmarks[op]
+			summary := r.Summary(op)
+			row := []string{
+				r.Orm,
+				op,
+				strconv.Itoa(result.N),
+				strconv.FormatInt(result.NsPerOp(), 10),
+				strconv.FormatInt(result.AllocedBytesPerOp(), 10),
+				strconv.FormatInt(result.AllocsPerOp(), 10),
+				formatMetric(result, benchmark.OpsPerSecMetric),
+				formatMetric(result, benchmark.LatencyMetric),
+				formatMetric(result, benchmark.P50Metric),
+				formatMetric(result, benchmark.P90Metric),
+				formatMetric(result, benchmark.P99Metric),
+				formatMetric(result, benchmark.P999Metric),
+				formatMetric(result, benchmark.MaxMetric),
+				formatMetric(result, benchmark.PeakHeapMetric),
+				strconv.Itoa(summary.N),
+				formatFloat(summary.Mean),
+				formatFloat(summary.Median),
+				formatFloat(summary.Stddev),
+				formatFloat(summary.CV),
+				formatFloat(summary.CILow),
+				formatFloat(summary.CIHigh),
+				errMessage,
+			}
+			if err := writer.Write(append(row, settings...)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeMarkdown(w io.Writer, config utils.Config, env environment.Environment, results []benchmark.ResultWrapper) error {
+	writeMarkdownPairs(w, "Config", "Setting", config.Pairs())
+	writeMarkdownPairs(w, "Environment", "Property", env.Pairs())
+
+	results = sortByOrm(results)
+	var operations []string
+	for _, r := range results {
+		for op := range r.Benchmarks {
+			if !slices.Contains(operations, op) {
+				operations = append(operations, op)
+			}
+		}
+	}
+	slices.Sort(operations)
+
+	for _, op := range operations {
+		_, _ = fmt.Fprintf(w, "### %s\n\n", op)
+		_, _ = fmt.Fprintln(w, "| ORM | N | ns/op | B/op | allocs/op | ops/s | p50 | p90 | p99 | p99.9 | max | runs | mean ± 95% CI | cv |")
+		_, _ = fmt.Fprintln(w, "|:----|--:|------:|-----:|----------:|------:|----:|----:|----:|------:|----:|-----:|--------------:|---:|")
+		for _, r := range results {
+			result, ok := r.Benchmarks[op]
+			if !ok {
+				continue
+			}
+			summary := r.Summary(op)
+			_, err := fmt.Fprintf(w, "| %s | %d | %d | %d | %d | %.0f | %s | %s | %s | %s | %s | %d | %.0f ± %.1f%% | %.1f%% |\n",
+				r.Orm,
+				result.N,
+				result.NsPerOp(),
+				result.AllocedBytesPerOp(),
+				result.AllocsPerOp(),
+				result.Extra[benchmark.OpsPerSecMetric],
+				time.Duration(result.Extra[benchmark.P50Metric]),
+				time.Duration(result.Extra[benchmark.P90Metric]),
+				time.Duration(result.Extra[benchmark.P99Metric]),
+				time.Duration(result.Extra[benchmark.P999Metric]),
+				time.Duration(result.Extra[benchmark.MaxMetric]),
+				summary.N,
+				summary.Mean,
+				summary.CIMargin()*100,
+				summary.CV*100,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+
+	for _, r := range results {
+		if r.Err != nil {
+			_, _ = fmt.Fprintf(w, "> **%s** failed: %s\n", r.Orm, r.Err)
+		}
+	}
+	return nil
+}
+
+func writeMarkdownPairs(w io.Writer, title, header string, pairs [][2]string) {
+	_, _ = fmt.Fprintf(w, "### %s\n\n", title)
+	_, _ = fmt.Fprintf(w, "| %s | Value |\n", header)
+	_, _ = fmt.Fprintln(w, "|:--------|:------|")
+	for _, pair := range pairs {
+		_, _ = fmt.Fprintf(w, "| %s | %s |\n", pair[0], pair[1])
+	}
+	_, _ = fmt.Fprintln(w)
+}
+
+// writeBenchfmt follows the Go benchmark data format, so the output can be fed to benchstat.
+// The environment provides the goos, goarch and cpu keys benchstat knows about.
+func writeBenchfmt(w io.Writer, config utils.Config, env environment.Environment, results []benchmark.ResultWrapper) error {
+	_, err := fmt.Fprintln(w, "pkg: github.com/andreiac-silva/golang-orm-benchmarks")
+	if err != nil {
+		return err
+	}
+	// Configuration lines use the "key: value" syntax of the format.
+	for _, pair := range append(env.Pairs(), config.Pairs()...) {
+		if _, err = fmt.Fprintf(w, "%s: %s\n", pair[0], pair[1]); err != nil {
+			return err
+		}
+	}
+	for _, r := range sortByOrm(results) {
+		for _, op := range sortedOperations(r.Benchmarks) {
+			// Every run is written as its own line, benchstat computes the statistics from them.
+			samples, ok := r.Samples[op]
+			if !ok {
+				samples = []testing.BenchmarkResult{r.Benchmarks[op]}
+			}
+			for _, result := range samples {
+				_, err = fmt.Fprintf(w, "%s\t%s\t%s\n", BenchmarkName(op, r.Orm), result.String(), result.MemString())
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// BenchmarkName returns the Go benchmark name of an ORM operation, e.g. BenchmarkInsertBulk/gorm-8.
+func BenchmarkName(operation, orm string) string {
+	var name strings.Builder
+	name.WriteString("Benchmark")
+	for _, word := range strings.Split(operation, "-") {
+		if word == "" {
+			continue
+		}
+		name.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return fmt.Sprintf("%s/%s-%d", name.String(), orm, runtime.GOMAXPROCS(0))
+}
+
+func formatMetric(result testing.BenchmarkResult, metric string) string {
+	return formatFloat(result.Extra[metric])
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func sortByOrm(results []benchmark.ResultWrapper) []benchmark.ResultWrapper {
+	sorted := slices.Clone(results)
+	slices.SortStableFunc(sorted, func(a, b benchmark.ResultWrapper) int {
+		return strings.Compare(a.Orm, b.Orm)
+	})
+	return sorted
+}
+
+func sortedOperations(benchmarks map[string]testing.BenchmarkResult) []string {
+	operations := make([]string, 0, len(benchmarks))
+	for op := range benchmarks {
+		operations = append(operations, op)
+	}
+	slices.Sort(operations)
+	return operations
+}
@@ -0,0 +1,139 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/environment"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+)
+
+// SweepPoint holds the results of a sweep at one value of its parameter.
+type SweepPoint struct {
+	Value   string
+	Config  utils.Config
+	Results []benchmark.ResultWrapper
+}
+
+// SweepDocument is the versioned JSON representation of a sweep.
+type SweepDocument struct {
+	SchemaVersion int                     `json:"schema_version"`
+	Parameter     string                  `json:"parameter"`
+	Environment   environment.Environment `json:"environment"`
+	Points        []SweepPointDocument    `json:"points"`
+}
+
+type SweepPointDocument struct {
+	Value   string       `json:"value"`
+	Config  utils.Config `json:"config"`
+	Results []Result     `json:"results"`
+}
+
+// WriteSweep encodes every point of the sweep into w using one of the Formats. JSON and CSV
+// hold one series per ORM and operation, Markdown renders a scaling table per operation and
+// benchfmt separates the points with their configuration lines, which benchstat can compare.
+func WriteSweep(w io.Writer, format, parameter string, env environment.Environment, points []SweepPoint) error {
+	switch format {
+	case JSON:
+		doc := SweepDocument{SchemaVersion: SchemaVersion, Parameter: parameter, Environment: env}
+		for _, p := range points {
+			doc.Points = append(doc.Points, SweepPointDocument{
+				Value:   p.Value,
+				Config:  p.Config,
+				Results: NewDocument(p.Config, env, p.Results).Results,
+			})
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(doc)
+	case CSV:
+		if len(points) == 0 {
+			return nil
+		}
+		writer := csv.NewWriter(w)
+		if err := writer.Write(csvHeaderFor(points[0].Config, env)); err != nil {
+			return err
+		}
+		for _, p := range points {
+			if err := writeCSVRows(writer, p.Config, env, p.Results); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case Markdown:
+		writeMarkdownPairs(w, "Environment", "Property", env.Pairs())
+		return writeSweepMarkdown(w, parameter, points)
+	case Benchfmt:
+		for _, p := range points {
+			if err := writeBenchfmt(w, p.Config, env, p.Results); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q, valid ones are: %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+func writeSweepMarkdown(w io.Writer, parameter string, points []SweepPoint) error {
+	operations, orms := SweepAxes(points)
+	for _, op := range operations {
+		_, _ = fmt.Fprintf(w, "### %s (ns/op by %s)\n\n", op, parameter)
+		header, separator := "| ORM |", "|:----|"
+		for _, p := range points {
+			header += " " + p.Value + " |"
+			separator += "----:|"
+		}
+		_, _ = fmt.Fprintln(w, header)
+		_, _ = fmt.Fprintln(w, separator)
+		for _, orm := range orms {
+			row := "| " + orm + " |"
+			for _, p := range points {
+				row += " " + SweepCell(p, orm, op) + " |"
+			}
+			if _, err := fmt.Fprintln(w, row); err != nil {
+				return err
+			}
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// SweepAxes returns the sorted operations and ORMs present in any point of the sweep.
+func SweepAxes(points []SweepPoint) (operations, orms []string) {
+	for _, p := range points {
+		for _, r := range p.Results {
+			if !slices.Contains(orms, r.Orm) {
+				orms = append(orms, r.Orm)
+			}
+			for op := range r.Benchmarks {
+				if !slices.Contains(operations, op) {
+					operations = append(operations, op)
+				}
+			}
+		}
+	}
+	slices.Sort(operations)
+	slices.Sort(orms)
+	return operations, orms
+}
+
+// SweepCell returns the ns/op of the ORM operation at the point, or "-" when it didn't run.
+func SweepCell(p SweepPoint, orm, operation string) string {
+	for _, r := range p.Results {
+		if r.Orm != orm {
+			continue
+		}
+		if result, ok := r.Benchmarks[operation]; ok {
+			return fmt.Sprintf("%d", result.NsPerOp())
+		}
+	}
+	return "-"
+}
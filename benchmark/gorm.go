@@ -1,25 +1,42 @@
 package benchmark
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
 	"github.com/andreiac-silva/golang-orm-benchmarks/model"
 
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 )
 
-type GormBenchmark struct {
+type GormBenchmark[K model.Key] struct {
 	db *gorm.DB
 }
 
 func NewGormBenchmark() Benchmark {
-	return &GormBenchmark{}
+	if utils.PrimaryKey == utils.UUIDKey {
+		return &GormBenchmark[uuid.UUID]{}
+	}
+	return &GormBenchmark[int64]{}
+}
+
+// byID returns the conditions selecting the book with the key. SERIAL keys are passed inline, as
+// GORM takes numbers as primary keys, while uuid.UUID ones need an explicit condition.
+func byID[K model.Key](id K) []interface{} {
+	if _, ok := any(id).(uuid.UUID); ok {
+		return []interface{}{"id = ?", id}
+	}
+	return []interface{}{id}
 }
 
-func (o *GormBenchmark) Init() error {
+func (o *GormBenchmark[K]) Init() error {
 	var err error
 	// The config follows the performance section of the GORM documentation: https://gorm.io/docs/performance.html.
 	pgConfig := postgres.New(postgres.Config{
@@ -31,10 +48,18 @@ func (o *GormBenchmark) Init() error {
 		Logger:                 logger.Default.LogMode(logger.Silent),
 	}
 	o.db, err = gorm.Open(pgConfig, gormConfig)
-	return err
+	if err != nil {
+		return err
+	}
+	sqlDB, err := o.db.DB()
+	if err != nil {
+		return err
+	}
+	utils.ConfigurePool(sqlDB)
+	return nil
 }
 
-func (o *GormBenchmark) Close() error {
+func (o *GormBenchmark[K]) Close() error {
 	sqlDB, err := o.db.DB()
 	if err != nil {
 		return err
@@ -42,159 +67,401 @@ func (o *GormBenchmark) Close() error {
 	return sqlDB.Close()
 }
 
-func (o *GormBenchmark) Insert(b *testing.B) {
-	book := model.NewBook()
-
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		book.ID = 0
-		b.StartTimer()
-
-		err := o.db.Create(book).Error
-
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+func (o *GormBenchmark[K]) Insert(b *testing.B) {
+	run(b, func() step {
+		book := model.NewBook[K]()
+		var zero K
+		return step{
+			prepare: func(int) {
+				book.ID = zero
+			},
+			exec: func(int) error {
+				return o.db.Create(book).Error
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
-func (o *GormBenchmark) InsertBulk(b *testing.B) {
-	books := model.NewBooks(utils.BulkInsertNumber)
-
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		for _, book := range books {
-			book.ID = 0
+func (o *GormBenchmark[K]) InsertBulk(b *testing.B) {
+	run(b, func() step {
+		books := model.NewBooks[K](utils.BulkInsertNumber)
+		var zero K
+		return step{
+			prepare: func(int) {
+				for _, book := range books {
+					book.ID = zero
+				}
+			},
+			exec: func(int) error {
+				return o.db.CreateInBatches(&books, utils.MaxBooksPerStatement).Error
+			},
 		}
-		b.StartTimer()
-
-		err := o.db.Create(&books).Error
+	})
+}
 
-		b.StopTimer()
-		if err != nil {
+func (o *GormBenchmark[K]) Update(b *testing.B) {
+	run(b, func() step {
+		book := model.NewBook[K]()
+		if err := o.db.Create(book).Error; err != nil {
 			b.Error(err)
+			return step{exec: func(int) error { return err }}
 		}
-		b.StartTimer()
-	}
+		return step{
+			exec: func(int) error {
+				return o.db.Save(book).Error
+			},
+		}
+	})
 }
 
-func (o *GormBenchmark) Update(b *testing.B) {
-	book := model.NewBook()
+func (o *GormBenchmark[K]) Delete(b *testing.B) {
+	n := b.N
+	books := model.NewBooks[K](n)
 
-	err := o.db.Create(book).Error
+	err := o.db.Create(books).Error
 	if err != nil {
 		b.Error(err)
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		err = o.db.Save(book).Error
-
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				return o.db.Delete(&model.Book[K]{}, byID(books[i].ID)...).Error
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
-func (o *GormBenchmark) Delete(b *testing.B) {
+func (o *GormBenchmark[K]) FindByID(b *testing.B) {
 	n := b.N
-	books := model.NewBooks(n)
+	books := model.NewBooks[K](n)
 
 	err := o.db.Create(books).Error
 	if err != nil {
 		b.Error(err)
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	var bookID int64
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		bookID = books[i].ID
-		b.StartTimer()
+	run(b, func() step {
+		var book *model.Book[K]
+		return step{
+			prepare: func(int) {
+				book = new(model.Book[K])
+			},
+			exec: func(i int) error {
+				return o.db.First(book, byID(books[i].ID)...).Error
+			},
+		}
+	})
+}
 
-		err = o.db.Delete(&model.Book{}, bookID).Error
+func (o *GormBenchmark[K]) FindPage(b *testing.B) {
+	n := b.N
+	books := model.NewBooks[K](n)
 
-		b.StopTimer()
+	// Persist it in batches > https://github.com/bitmagnet-io/bitmagnet/issues/126.
+	batches := model.Chunk(books, utils.BatchSize)
+	for _, chunk := range batches {
+		err := o.db.Create(chunk).Error
 		if err != nil {
 			b.Error(err)
 		}
-		b.StartTimer()
+
 	}
+	keys := bookKeys(books)
+
+	run(b, func() step {
+		var booksPage []model.Book[K]
+		return step{
+			prepare: func(int) {
+				booksPage = make([]model.Book[K], utils.PageSize)
+			},
+			exec: func(i int) error {
+				return o.db.Limit(utils.PageSize).Where("id > ?", cursorAt(keys, i)).Order("id").Find(&booksPage).Error
+			},
+		}
+	})
 }
 
-func (o *GormBenchmark) FindByID(b *testing.B) {
-	n := b.N
-	books := model.NewBooks(n)
-
-	err := o.db.Create(books).Error
+func (o *GormBenchmark[K]) FindWithActivePolicy(b *testing.B) {
+	now := time.Now().UTC()
+	keys, err := seedPricedBooks[K](b.N, now)
 	if err != nil {
 		b.Error(err)
+		return
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+	run(b, func() step {
+		var booksPage []model.Book[K]
+		return step{
+			prepare: func(int) {
+				booksPage = make([]model.Book[K], utils.PageSize)
+			},
+			exec: func(i int) error {
+				return o.db.
+					InnerJoins("ActivePolicy", o.db.Where(`"ActivePolicy".start_date <= ? AND "ActivePolicy".end_date > ?`, now, now)).
+					Where("books.id > ?", cursorAt(keys, i)).
+					Order("books.id").
+					Limit(utils.PageSize).
+					Find(&booksPage).Error
+			},
+		}
+	})
+}
 
-	var book *model.Book
-	var bookID int64
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		book = new(model.Book)
-		bookID = books[i].ID
-		b.StartTimer()
+func (o *GormBenchmark[K]) FindPageWithPolicies(strategy LoadStrategy) func(b *testing.B) {
+	switch strategy {
+	case NPlusOne:
+		return eagerBenchmark(func(cursor K) error {
+			var books []model.Book[K]
+			if err := o.db.Limit(utils.PageSize).Where("id > ?", cursor).Order("id").Find(&books).Error; err != nil {
+				return err
+			}
+			for j := range books {
+				if err := o.db.Where("book_id = ?", books[j].ID).Order("id").Find(&books[j].Policies).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	case Preload:
+		return eagerBenchmark(func(cursor K) error {
+			var books []model.Book[K]
+			return o.db.Preload("Policies").Limit(utils.PageSize).Where("id > ?", cursor).Order("id").Find(&books).Error
+		})
+	case Join:
+		// Joins only loads has-one and belongs-to relations, so the rows are scanned and grouped.
+		return eagerBenchmark(func(cursor K) error {
+			var rows []bookPolicyRow[K]
+			err := o.db.Model(&model.Book[K]{}).
+				Select("books.*, pp.id AS policy_id, pp.price, pp.start_date, pp.end_date").
+				Joins("JOIN price_policies pp ON pp.book_id = books.id").
+				Where("books.id IN (?)", o.db.Model(&model.Book[K]{}).Select("id").Where("id > ?", cursor).Order("id").Limit(utils.PageSize)).
+				Order("books.id, pp.id").
+				Scan(&rows).Error
+			if err != nil {
+				return err
+			}
+			_ = groupPolicies(rows)
+			return nil
+		})
+	}
+	return nil
+}
 
-		err = o.db.First(book, bookID).Error
+func (o *GormBenchmark[K]) Transaction(b *testing.B) {
+	o.transaction(b, false)
+}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+func (o *GormBenchmark[K]) TransactionRollback(b *testing.B) {
+	o.transaction(b, true)
+}
+
+func (o *GormBenchmark[K]) transaction(b *testing.B, rollback bool) {
+	now := time.Now().UTC()
+
+	run(b, func() step {
+		book := model.NewBook[K]()
+		var zero K
+		return step{
+			prepare: func(int) {
+				book.ID = zero
+			},
+			exec: func(int) error {
+				err := o.db.Transaction(func(tx *gorm.DB) error {
+					if err := tx.Create(book).Error; err != nil {
+						return err
+					}
+					if err := tx.Create(model.NewPricePolicies(book.ID, now)).Error; err != nil {
+						return err
+					}
+					if rollback {
+						return errRollback
+					}
+					return nil
+				})
+				if errors.Is(err, errRollback) {
+					return nil
+				}
+				return err
+			},
 		}
-		b.StartTimer()
+	})
+}
+
+func (o *GormBenchmark[K]) Upsert(b *testing.B) {
+	onConflict := clause.OnConflict{
+		Columns:   []clause.Column{{Name: "isbn"}},
+		DoUpdates: clause.AssignmentColumns([]string{"quantity"}),
 	}
+	upsertBenchmark(b, func(books []*model.Book[K]) error {
+		return o.db.Clauses(onConflict).CreateInBatches(&books, utils.MaxBooksPerStatement).Error
+	})
 }
 
-func (o *GormBenchmark) FindPage(b *testing.B) {
-	n := b.N
-	books := model.NewBooks(n)
+func (o *GormBenchmark[K]) UpdateBulk(affected int) func(b *testing.B) {
+	return updateBulkBenchmark(affected, func(genre string, quantity int) error {
+		return o.db.Model(&model.Book[K]{}).Where("genre = ?", genre).Updates(map[string]interface{}{"quantity": quantity}).Error
+	})
+}
 
-	// Persist it in batches > https://github.com/bitmagnet-io/bitmagnet/issues/126.
-	batches := model.Chunk(books, utils.BatchSize)
-	for _, chunk := range batches {
-		err := o.db.Create(chunk).Error
-		if err != nil {
-			b.Error(err)
-		}
+func (o *GormBenchmark[K]) DeleteBulk(affected int) func(b *testing.B) {
+	return deleteBulkBenchmark(affected, func(genre string) error {
+		return o.db.Where("genre = ?", genre).Delete(&model.Book[K]{}).Error
+	})
+}
 
+// FindByIDs only supports InList, as GORM expands every slice argument into a list.
+func (o *GormBenchmark[K]) FindByIDs(list IDList) func(b *testing.B) {
+	if list != InList {
+		return nil
 	}
+	return manyIDsBenchmark(func(ids []K) error {
+		var books []model.Book[K]
+		return o.db.Find(&books, ids).Error
+	})
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+func (o *GormBenchmark[K]) Aggregate(b *testing.B) {
+	aggregateBenchmark(b, func() error {
+		var stats []genreStats
+		return o.db.Model(&model.Book[K]{}).
+			Select("genre, COUNT(*) AS books, SUM(quantity) AS total_quantity, AVG(quantity) AS average_quantity").
+			Group("genre").
+			Scan(&stats).Error
+	})
+}
 
-	booksPage := make([]model.Book, utils.PageSize)
-	for i := 0; i < n; i++ {
-		b.StopTimer()
-		booksPage = make([]model.Book, utils.PageSize)
-		b.StartTimer()
+func (o *GormBenchmark[K]) Search(b *testing.B) {
+	searchBenchmark(b, func(f searchFilter) error {
+		query := o.db.Model(&model.Book[K]{})
+		if f.AuthorPrefix != nil {
+			query = query.Where("author LIKE ?", *f.AuthorPrefix+"%")
+		}
+		if f.Genre != nil {
+			query = query.Where("genre = ?", *f.Genre)
+		}
+		if f.PublishedAfter != nil {
+			query = query.Where("publicized_at >= ?", *f.PublishedAfter)
+		}
+		if f.PublishedBefore != nil {
+			query = query.Where("publicized_at < ?", *f.PublishedBefore)
+		}
+		if f.MinQuantity != nil {
+			query = query.Where("quantity >= ?", *f.MinQuantity)
+		}
+		if f.MaxQuantity != nil {
+			query = query.Where("quantity <= ?", *f.MaxQuantity)
+		}
 
-		err := o.db.Limit(utils.PageSize).Where("id > ?", i).Find(&booksPage).Error
+		var books []model.Book[K]
+		return query.
+			Order(clause.OrderByColumn{Column: clause.Column{Name: f.SortBy}, Desc: f.Descending}).
+			Order("id").
+			Limit(utils.PageSize).
+			Find(&books).Error
+	})
+}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+func (o *GormBenchmark[K]) FindPageAt(pagination Pagination, depth int) func(b *testing.B) {
+	return paginationBenchmark(depth, func(depth int, cursor K) error {
+		var books []model.Book[K]
+		query := o.db.Limit(utils.PageSize).Order("id")
+		if pagination == Offset {
+			return query.Offset(depth).Find(&books).Error
 		}
-		b.StartTimer()
+		return query.Where("id > ?", cursor).Find(&books).Error
+	})
+}
+
+func (o *GormBenchmark[K]) FindTitles(b *testing.B) {
+	projectionBenchmark(b, func(cursor K) error {
+		var titles []bookTitle[K]
+		return o.db.Model(&model.Book[K]{}).
+			Select("id", "title").
+			Where("id > ?", cursor).
+			Order("id").
+			Limit(utils.PageSize).
+			Find(&titles).Error
+	})
+}
+
+func (o *GormBenchmark[K]) ScanLarge(mode ScanMode) func(b *testing.B) {
+	switch mode {
+	case Buffered:
+		return scanLargeBenchmark(func() error {
+			var books []model.Book[K]
+			return o.db.Find(&books).Error
+		})
+	case Streaming:
+		return scanLargeBenchmark(func() error {
+			rows, err := o.db.Model(&model.Book[K]{}).Rows()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = rows.Close()
+			}()
+
+			var book model.Book[K]
+			for rows.Next() {
+				if err = o.db.ScanRows(rows, &book); err != nil {
+					return err
+				}
+			}
+			return rows.Err()
+		})
 	}
+	return nil
+}
+
+func (o *GormBenchmark[K]) InsertNullable(b *testing.B) {
+	insertNullableBenchmark(b, func(book *model.NullableBook[K]) error {
+		return o.db.Create(book).Error
+	})
+}
+
+func (o *GormBenchmark[K]) FindNullable(b *testing.B) {
+	findNullableBenchmark(b, func(cursor K) error {
+		var books []model.NullableBook[K]
+		return o.db.Limit(utils.PageSize).Where("id > ?", cursor).Order("id").Find(&books).Error
+	})
+}
+
+func (o *GormBenchmark[K]) UpdateNullable(b *testing.B) {
+	updateNullableBenchmark(b, func(book *model.NullableBook[K]) error {
+		return o.db.Save(book).Error
+	})
+}
+
+func (o *GormBenchmark[K]) InsertJSON(size DocumentSize) func(b *testing.B) {
+	return insertJSONBenchmark(size, func(book *model.JSONBook[K]) error {
+		return o.db.Create(book).Error
+	})
+}
+
+func (o *GormBenchmark[K]) FindJSON(size DocumentSize) func(b *testing.B) {
+	return findJSONBenchmark(size, func(cursor K) error {
+		var books []model.JSONBook[K]
+		return o.db.Limit(utils.PageSize).Where("id > ?", cursor).Order("id").Find(&books).Error
+	})
+}
+
+func (o *GormBenchmark[K]) InsertTags(b *testing.B) {
+	insertTagsBenchmark(b, func(book *model.TaggedBook[K]) error {
+		return o.db.Create(book).Error
+	})
+}
+
+func (o *GormBenchmark[K]) FindTags(b *testing.B) {
+	findTagsBenchmark(b, func(cursor K) error {
+		var books []model.TaggedBook[K]
+		return o.db.Limit(utils.PageSize).Where("id > ?", cursor).Order("id").Find(&books).Error
+	})
+}
+
+func (o *GormBenchmark[K]) FilterTags(b *testing.B) {
+	filterTagsBenchmark[K](b, func(tags []string) error {
+		var books []model.TaggedBook[K]
+		return o.db.Limit(utils.PageSize).Where("tags @> ?", pq.StringArray(tags)).Order("id").Find(&books).Error
+	})
 }
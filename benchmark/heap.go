@@ -0,0 +1,58 @@
+package benchmark
+
+import (
+	"runtime"
+	"runtime/metrics"
+	"sync"
+	"time"
+)
+
+// PeakHeapMetric is the highest heap in use observed while a benchmark ran, over the heap in use
+// before it. Like runtime.MemStats.HeapAlloc, it counts the objects the GC has yet to free.
+const PeakHeapMetric = "peak-heap-B"
+
+const heapObjectsMetric = "/memory/classes/heap/objects:bytes"
+
+// heapPeak samples the heap in use every millisecond until stopped, keeping the highest value.
+type heapPeak struct {
+	baseline uint64
+	peak     uint64
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// startHeapPeak collects the garbage to take the baseline, then starts sampling.
+func startHeapPeak() *heapPeak {
+	runtime.GC()
+	h := &heapPeak{baseline: heapInUse(), done: make(chan struct{})}
+	h.peak = h.baseline
+
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		ticker := time.NewTicker(time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-h.done:
+				return
+			case <-ticker.C:
+				h.peak = max(h.peak, heapInUse())
+			}
+		}
+	}()
+	return h
+}
+
+// stop ends the sampling and returns the peak over the baseline, in bytes.
+func (h *heapPeak) stop() float64 {
+	close(h.done)
+	h.wg.Wait()
+	return float64(h.peak - h.baseline)
+}
+
+func heapInUse() uint64 {
+	sample := []metrics.Sample{{Name: heapObjectsMetric}}
+	metrics.Read(sample)
+	return sample[0].Value.Uint64()
+}
@@ -0,0 +1,179 @@
+package histogram
+
+import (
+	"encoding/json"
+	"math"
+	"math/bits"
+	"time"
+)
+
+// subBucketBits sets the precision: every power of two range is split into 2^(subBucketBits-1)
+// linear sub-buckets, which keeps the recorded values within 1% of the real ones.
+const (
+	subBucketBits  = 8
+	subBucketCount = 1 << subBucketBits
+	subBucketHalf  = subBucketCount >> 1
+	bucketsLength  = (64-subBucketBits+1)*subBucketHalf + subBucketHalf
+)
+
+// Histogram is an HDR-style latency histogram with logarithmic buckets and linear
+// sub-buckets. It is not safe for concurrent use; record per goroutine and Merge instead.
+type Histogram struct {
+	counts []int64
+	total  int64
+	sum    int64
+	min    int64
+	max    int64
+}
+
+// Bucket is a non-empty histogram bucket holding Count values lower than or equal to UpperNs.
+type Bucket struct {
+	UpperNs int64 `json:"upper_ns"`
+	Count   int64 `json:"count"`
+}
+
+func New() *Histogram {
+	return &Histogram{
+		counts: make([]int64, bucketsLength),
+		min:    math.MaxInt64,
+	}
+}
+
+// Record adds a single latency to the histogram.
+func (h *Histogram) Record(d time.Duration) {
+	v := max(int64(d), 0)
+	h.counts[index(v)]++
+	h.total++
+	h.sum += v
+	h.min = min(h.min, v)
+	h.max = max(h.max, v)
+}
+
+// Merge adds every value recorded by other into h.
+func (h *Histogram) Merge(other *Histogram) {
+	if other == nil || other.total == 0 {
+		return
+	}
+	for i, c := range other.counts {
+		h.counts[i] += c
+	}
+	h.total += other.total
+	h.sum += other.sum
+	h.min = min(h.min, other.min)
+	h.max = max(h.max, other.max)
+}
+
+func (h *Histogram) Count() int64 {
+	return h.total
+}
+
+func (h *Histogram) Min() time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	return time.Duration(h.min)
+}
+
+func (h *Histogram) Max() time.Duration {
+	return time.Duration(h.max)
+}
+
+func (h *Histogram) Mean() time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	return time.Duration(h.sum / h.total)
+}
+
+// Percentile returns the highest value equivalent to the q-th percentile, with q in [0, 100].
+func (h *Histogram) Percentile(q float64) time.Duration {
+	if h.total == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(q / 100 * float64(h.total)))
+	rank = min(max(rank, 1), h.total)
+
+	var seen int64
+	for i, c := range h.counts {
+		seen += c
+		if seen >= rank {
+			return time.Duration(min(upper(i), h.max))
+		}
+	}
+	return time.Duration(h.max)
+}
+
+// Buckets returns the non-empty buckets in ascending order.
+func (h *Histogram) Buckets() []Bucket {
+	var buckets []Bucket
+	for i, c := range h.counts {
+		if c > 0 {
+			buckets = append(buckets, Bucket{UpperNs: upper(i), Count: c})
+		}
+	}
+	return buckets
+}
+
+type snapshot struct {
+	Count   int64    `json:"count"`
+	MinNs   int64    `json:"min_ns"`
+	MaxNs   int64    `json:"max_ns"`
+	MeanNs  int64    `json:"mean_ns"`
+	P50Ns   int64    `json:"p50_ns"`
+	P90Ns   int64    `json:"p90_ns"`
+	P99Ns   int64    `json:"p99_ns"`
+	P999Ns  int64    `json:"p99_9_ns"`
+	SumNs   int64    `json:"sum_ns"`
+	Buckets []Bucket `json:"buckets"`
+}
+
+func (h *Histogram) MarshalJSON() ([]byte, error) {
+	return json.Marshal(snapshot{
+		Count:   h.total,
+		MinNs:   int64(h.Min()),
+		MaxNs:   int64(h.Max()),
+		MeanNs:  int64(h.Mean()),
+		P50Ns:   int64(h.Percentile(50)),
+		P90Ns:   int64(h.Percentile(90)),
+		P99Ns:   int64(h.Percentile(99)),
+		P999Ns:  int64(h.Percentile(99.9)),
+		SumNs:   h.sum,
+		Buckets: h.Buckets(),
+	})
+}
+
+// UnmarshalJSON rebuilds the histogram from its buckets; the percentiles are recomputed from them.
+func (h *Histogram) UnmarshalJSON(data []byte) error {
+	var s snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*h = *New()
+	for _, b := range s.Buckets {
+		h.counts[index(b.UpperNs)] += b.Count
+		h.total += b.Count
+	}
+	h.sum = s.SumNs
+	if h.total > 0 {
+		h.min = s.MinNs
+		h.max = s.MaxNs
+	}
+	return nil
+}
+
+func index(v int64) int {
+	if v < subBucketCount {
+		return int(v)
+	}
+	shift := bits.Len64(uint64(v)) - subBucketBits
+	return shift*subBucketHalf + int(v>>shift)
+}
+
+func upper(i int) int64 {
+	if i < subBucketCount {
+		return int64(i)
+	}
+	shift := i/subBucketHalf - 1
+	sub := int64(i - shift*subBucketHalf)
+	return (sub+1)<<shift - 1
+}
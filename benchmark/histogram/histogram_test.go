@@ -0,0 +1,97 @@
+package histogram
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestIndex(t *testing.T) {
+	tests := []struct {
+		value int64
+		want  int
+	}{
+		{value: 0, want: 0},
+		{value: 1, want: 1},
+		{value: 255, want: 255},
+		{value: 256, want: 256},
+		{value: 257, want: 256},
+		{value: 258, want: 257},
+		{value: 511, want: 383},
+		{value: 512, want: 384},
+		{value: 515, want: 384},
+		{value: 516, want: 385},
+		{value: 1023, want: 511},
+		{value: 1024, want: 512},
+		{value: math.MaxInt64, want: 7295},
+	}
+	for _, tt := range tests {
+		if got := index(tt.value); got != tt.want {
+			t.Errorf("index(%d) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestUpper(t *testing.T) {
+	tests := []struct {
+		index int
+		want  int64
+	}{
+		{index: 0, want: 0},
+		{index: 255, want: 255},
+		{index: 256, want: 257},
+		{index: 257, want: 259},
+		{index: 383, want: 511},
+		{index: 384, want: 515},
+		{index: 511, want: 1023},
+		{index: 512, want: 1031},
+		{index: 7295, want: math.MaxInt64},
+	}
+	for _, tt := range tests {
+		if got := upper(tt.index); got != tt.want {
+			t.Errorf("upper(%d) = %d, want %d", tt.index, got, tt.want)
+		}
+	}
+}
+
+// TestIndexUpperBound checks that every value falls in the bucket whose upper bound is the
+// closest one not lower than the value, and within 1% of it.
+func TestIndexUpperBound(t *testing.T) {
+	values := []int64{0, 1, 100, 255, 256, 300, 511, 512, 1000, 4096, 123_456, 1_000_000, 987_654_321, 1 << 40}
+	for _, v := range values {
+		i := index(v)
+		bound := upper(i)
+		if bound < v {
+			t.Errorf("upper(index(%d)) = %d, lower than the value", v, bound)
+		}
+		if i > 0 && upper(i-1) >= v {
+			t.Errorf("upper(index(%d) - 1) = %d, the value belongs to a lower bucket", v, upper(i-1))
+		}
+		if float64(bound-v) > float64(v)/100 {
+			t.Errorf("upper(index(%d)) = %d, more than 1%% away", v, bound)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	h := New()
+	for i := 1; i <= 100; i++ {
+		h.Record(time.Duration(i) * time.Microsecond)
+	}
+
+	tests := []struct {
+		q    float64
+		want time.Duration
+	}{
+		{q: 0, want: time.Duration(upper(index(1000)))},
+		{q: 50, want: time.Duration(upper(index(50_000)))},
+		{q: 90, want: time.Duration(upper(index(90_000)))},
+		{q: 99, want: time.Duration(upper(index(99_000)))},
+		{q: 100, want: 100 * time.Microsecond},
+	}
+	for _, tt := range tests {
+		if got := h.Percentile(tt.q); got != tt.want {
+			t.Errorf("Percentile(%v) = %v, want %v", tt.q, got, tt.want)
+		}
+	}
+}
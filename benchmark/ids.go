@@ -0,0 +1,74 @@
+package benchmark
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// IDList is a way of passing a list of IDs to a query.
+type IDList string
+
+const (
+	// InList expands the IDs into one placeholder each, as in id IN ($1, $2, ...).
+	InList IDList = "in"
+	// AnyArray binds the IDs as a single array parameter, as in id = ANY($1).
+	AnyArray IDList = "any"
+)
+
+// IDLists lists every way of FindByIDs.
+var IDLists = []IDList{InList, AnyArray}
+
+// manyIDsBenchmark seeds ten times utils.IDsNumber books, then measures find fetching
+// utils.IDsNumber distinct ones picked at random per iteration.
+func manyIDsBenchmark[K model.Key](find func(ids []K) error) func(b *testing.B) {
+	return func(b *testing.B) {
+		seeded, err := seedKeyedBooks[K](utils.IDsNumber * 10)
+		if err != nil {
+			b.Error(err)
+			return
+		}
+
+		run(b, func() step {
+			ids := make([]K, len(seeded))
+			copy(ids, seeded)
+			rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+			return step{
+				prepare: func(int) {
+					rng.Shuffle(len(ids), func(i, j int) {
+						ids[i], ids[j] = ids[j], ids[i]
+					})
+				},
+				exec: func(int) error {
+					return find(ids[:utils.IDsNumber])
+				},
+			}
+		})
+	}
+}
+
+// selectByIDsInQuery returns utils.SelectByIDsInQuery with a placeholder per ID.
+func selectByIDsInQuery[K model.Key](ids []K) (string, []interface{}) {
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "$" + strconv.Itoa(i+1)
+		args[i] = id
+	}
+	return fmt.Sprintf(utils.SelectByIDsInQuery, strings.Join(placeholders, ",")), args
+}
+
+// ids32 converts the IDs to the int32 of the sqlc generated code.
+func ids32(ids []int64) []int32 {
+	converted := make([]int32, len(ids))
+	for i, id := range ids {
+		converted[i] = int32(id)
+	}
+	return converted
+}
@@ -0,0 +1,79 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// DocumentSize is the size of the metadata document of the books in the JSONB variant.
+type DocumentSize string
+
+// The number of reviews of each size is configurable, see utils.SmallDocumentReviews.
+const (
+	// Small documents take about 150 bytes by default, without reviews.
+	Small DocumentSize = "small"
+	// Medium documents take about 2 KB by default, with 10 reviews.
+	Medium DocumentSize = "medium"
+	// Large documents take about 48 KB by default, with 250 reviews.
+	Large DocumentSize = "large"
+)
+
+// DocumentSizes lists every size of InsertJSON and FindJSON.
+var DocumentSizes = []DocumentSize{Small, Medium, Large}
+
+// reviews returns the number of reviews of the documents of the size.
+func (s DocumentSize) reviews() int {
+	switch s {
+	case Medium:
+		return utils.MediumDocumentReviews
+	case Large:
+		return utils.LargeDocumentReviews
+	}
+	return utils.SmallDocumentReviews
+}
+
+var jsonColumns = []string{"isbn", "title", "author", "genre", "quantity", "publicized_at", "metadata"}
+
+// insertJSONBenchmark measures insert with books of the JSONB variant whose documents have the size.
+func insertJSONBenchmark[K model.Key](size DocumentSize, insert func(book *model.JSONBook[K]) error) func(b *testing.B) {
+	return func(b *testing.B) {
+		run(b, func() step {
+			book := model.NewJSONBook[K](size.reviews())
+			var zero K
+			return step{
+				prepare: func(int) {
+					book.ID = zero
+				},
+				exec: func(int) error {
+					return insert(book)
+				},
+			}
+		})
+	}
+}
+
+// findJSONBenchmark seeds the books of the JSONB variant with documents of the size, then measures
+// find fetching the page after the cursor of the iteration, like FindPage does.
+func findJSONBenchmark[K model.Key](size DocumentSize, find func(cursor K) error) func(b *testing.B) {
+	return func(b *testing.B) {
+		if err := truncateJSONBooks(); err != nil {
+			b.Error(err)
+			return
+		}
+		ids, err := seedJSONBooks[K](b.N, size.reviews())
+		if err != nil {
+			b.Error(err)
+			return
+		}
+
+		run(b, func() step {
+			return step{
+				exec: func(i int) error {
+					return find(cursorAt(ids, i))
+				},
+			}
+		})
+	}
+}
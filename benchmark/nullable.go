@@ -0,0 +1,81 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// nullableColumns are the columns of the nullable_books table written by nullableBookValues.
+var nullableColumns = []string{
+	"isbn", "title", "subtitle", "description", "author", "genre", "quantity", "publicized_at", "discontinued_at",
+}
+
+// nullableBookValues returns the values of the book in the order of nullableColumns.
+func nullableBookValues[K model.Key](book *model.NullableBook[K]) []interface{} {
+	return []interface{}{
+		book.ISBN, book.Title, book.Subtitle, book.Description, book.Author, book.Genre, book.Quantity,
+		book.PublicizedAt, book.DiscontinuedAt,
+	}
+}
+
+// insertNullableBenchmark measures insert with books of the nullable variant, alternating between
+// one with every nullable column set and one with all of them NULL.
+func insertNullableBenchmark[K model.Key](b *testing.B, insert func(book *model.NullableBook[K]) error) {
+	run(b, func() step {
+		books := model.NewNullableBooks[K](2)
+		var zero K
+		return step{
+			prepare: func(i int) {
+				books[i%2].ID = zero
+			},
+			exec: func(i int) error {
+				return insert(books[i%2])
+			},
+		}
+	})
+}
+
+// findNullableBenchmark seeds the books of the nullable variant, then measures find fetching the
+// page after the cursor of the iteration, like FindPage does. Half of the books have NULL in the
+// nullable columns.
+func findNullableBenchmark[K model.Key](b *testing.B, find func(cursor K) error) {
+	if err := truncateNullableBooks(); err != nil {
+		b.Error(err)
+		return
+	}
+	ids, err := seedNullableBooks[K](b.N)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				return find(cursorAt(ids, i))
+			},
+		}
+	})
+}
+
+// updateNullableBenchmark seeds a book of the nullable variant per goroutine, then measures update
+// saving it with every nullable column alternately set and NULL.
+func updateNullableBenchmark[K model.Key](b *testing.B, update func(book *model.NullableBook[K]) error) {
+	run(b, func() step {
+		books := model.NewNullableBooks[K](2)
+		ids, err := seedNullableBooks[K](1)
+		if err != nil {
+			b.Error(err)
+			return step{exec: func(int) error { return err }}
+		}
+		for _, book := range books {
+			book.ID = ids[0]
+		}
+		return step{
+			exec: func(i int) error {
+				return update(books[i%2])
+			},
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// Pagination is a way of fetching a page deep into the books.
+type Pagination string
+
+const (
+	// Keyset skips to the page with WHERE id > the key of the last skipped book, walking the primary
+	// key index.
+	Keyset Pagination = "keyset"
+	// Offset skips to the page with OFFSET depth, reading every row before it.
+	Offset Pagination = "offset"
+)
+
+// Paginations lists every way of FindPageAt.
+var Paginations = []Pagination{Keyset, Offset}
+
+// PageDepths lists the number of books FindPageAt skips before its page.
+var PageDepths = []int{1000, 10000, 100000}
+
+// paginationBenchmark seeds depth books and a page more, then measures find fetching the page after
+// depth books, the last of which has the cursor key.
+func paginationBenchmark[K model.Key](depth int, find func(depth int, cursor K) error) func(b *testing.B) {
+	return func(b *testing.B) {
+		if err := truncateBooks(); err != nil {
+			b.Error(err)
+			return
+		}
+		keys, err := seedKeyedBooks[K](depth + utils.PageSize)
+		if err != nil {
+			b.Error(err)
+			return
+		}
+
+		cursor := cursorAt(keys, depth)
+		run(b, func() step {
+			return step{
+				exec: func(int) error {
+					return find(depth, cursor)
+				},
+			}
+		})
+	}
+}
+
+// cursorAt returns the cursor of the page starting at the i-th of the keys: the key before it, or
+// the zero key, lower than all the others, for the first one. It is i with SERIAL keys from one.
+func cursorAt[K model.Key](keys []K, i int) K {
+	if i == 0 {
+		var zero K
+		return zero
+	}
+	return keys[i-1]
+}
+
+// bookKeys returns the keys of the books, in their order.
+func bookKeys[K model.Key](books []*model.Book[K]) []K {
+	keys := make([]K, len(books))
+	for i, book := range books {
+		keys[i] = book.ID
+	}
+	return keys
+}
@@ -3,100 +3,121 @@ package benchmark
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
 	"github.com/andreiac-silva/golang-orm-benchmarks/model"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
 
 var columns = []string{"isbn", "title", "author", "genre", "quantity", "publicized_at"}
 
-type PgxBenchmark struct {
-	db  *pgx.Conn
+type PgxBenchmark[K model.Key] struct {
+	db  utils.PgxConn
 	ctx context.Context
 }
 
 func NewPgxBenchmark() Benchmark {
-	return &PgxBenchmark{
+	if utils.PrimaryKey == utils.UUIDKey {
+		return &PgxBenchmark[uuid.UUID]{
+			ctx: context.Background(),
+		}
+	}
+	return &PgxBenchmark[int64]{
 		ctx: context.Background(),
 	}
 }
 
-func (p *PgxBenchmark) Init() error {
+func (p *PgxBenchmark[K]) Init() error {
 	var err error
-	p.db, err = pgx.Connect(p.ctx, utils.PostgresDSN)
+	p.db, err = utils.NewPgxConn(p.ctx)
 	return err
 }
 
-func (p *PgxBenchmark) Close() error {
-	return p.db.Close(p.ctx)
+func (p *PgxBenchmark[K]) Close() error {
+	return utils.ClosePgxConn(p.ctx, p.db)
 }
 
-func (p *PgxBenchmark) Insert(b *testing.B) {
-	book := model.NewBook()
-
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		_, err := p.db.Exec(p.ctx, utils.InsertQuery,
-			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+func (p *PgxBenchmark[K]) Insert(b *testing.B) {
+	book := model.NewBook[K]()
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				_, err := p.db.Exec(p.ctx, utils.InsertQuery,
+					book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+				return err
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
-func (p *PgxBenchmark) InsertBulk(b *testing.B) {
+func (p *PgxBenchmark[K]) InsertBulk(b *testing.B) {
 	var rows = make([][]interface{}, 0)
-	for _, book := range model.NewBooks(utils.BulkInsertNumber) {
+	for _, book := range model.NewBooks[K](utils.BulkInsertNumber) {
 		rows = append(rows, []interface{}{book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt})
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		_, err := p.db.CopyFrom(p.ctx, pgx.Identifier{"books"}, columns, pgx.CopyFromRows(rows))
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				_, err := p.db.CopyFrom(p.ctx, pgx.Identifier{"books"}, columns, pgx.CopyFromRows(rows))
+				return err
+			},
+		}
+	})
+}
 
-		b.StopTimer()
+func (p *PgxBenchmark[K]) Update(b *testing.B) {
+	run(b, func() step {
+		book := model.NewBook[K]()
+		var id K
+		err := p.db.QueryRow(p.ctx, utils.InsertReturningIDQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
 		if err != nil {
 			b.Error(err)
+			return step{exec: func(int) error { return err }}
 		}
-		b.StartTimer()
-	}
+		return step{
+			exec: func(int) error {
+				_, err := p.db.Exec(p.ctx, utils.UpdateQuery,
+					book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt, id)
+				return err
+			},
+		}
+	})
 }
 
-func (p *PgxBenchmark) Update(b *testing.B) {
-	book := model.NewBook()
-	var id int64
-	err := p.db.QueryRow(p.ctx, utils.InsertReturningIDQuery,
-		book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
-
-	b.ReportAllocs()
-	b.ResetTimer()
-
+func (p *PgxBenchmark[K]) Delete(b *testing.B) {
+	book := model.NewBook[K]()
+	savedIDs := make([]K, b.N)
 	for i := 0; i < b.N; i++ {
-		_, err = p.db.Exec(p.ctx, utils.UpdateQuery,
-			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt, id)
-
-		b.StopTimer()
+		var id K
+		err := p.db.QueryRow(p.ctx, utils.InsertReturningIDQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
 		if err != nil {
 			b.Error(err)
 		}
-		b.StartTimer()
+		savedIDs[i] = id
 	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := p.db.Exec(p.ctx, utils.DeleteQuery, savedIDs[i])
+				return err
+			},
+		}
+	})
 }
 
-func (p *PgxBenchmark) Delete(b *testing.B) {
-	book := model.NewBook()
-	savedIDs := make([]int64, b.N)
+func (p *PgxBenchmark[K]) FindByID(b *testing.B) {
+	book := model.NewBook[K]()
+	savedIDs := make([]K, b.N)
 	for i := 0; i < b.N; i++ {
-		var id int64
+		var id K
 		err := p.db.QueryRow(p.ctx, utils.InsertReturningIDQuery,
 			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
 		if err != nil {
@@ -105,110 +126,451 @@ func (p *PgxBenchmark) Delete(b *testing.B) {
 		savedIDs[i] = id
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	var bookID int64
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		bookID = savedIDs[i]
-		b.StartTimer()
+	run(b, func() step {
+		var foundBook model.Book[K]
+		return step{
+			prepare: func(int) {
+				foundBook = model.Book[K]{}
+			},
+			exec: func(i int) error {
+				return p.db.QueryRow(p.ctx, utils.SelectByIDQuery, savedIDs[i]).Scan(
+					&foundBook.ID,
+					&foundBook.ISBN,
+					&foundBook.Title,
+					&foundBook.Author,
+					&foundBook.Genre,
+					&foundBook.Quantity,
+					&foundBook.PublicizedAt,
+				)
+			},
+		}
+	})
+}
 
-		_, err := p.db.Exec(p.ctx, utils.DeleteQuery, bookID)
+func (p *PgxBenchmark[K]) FindPage(b *testing.B) {
+	keys, err := seedKeyedBooks[K](b.N)
+	if err != nil {
+		b.Error(err)
+	}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		var booksPage []model.Book[K]
+		return step{
+			prepare: func(int) {
+				booksPage = make([]model.Book[K], utils.PageSize)
+			},
+			exec: func(i int) error {
+				result, err := p.db.Query(p.ctx, utils.SelectPaginatingQuery, cursorAt(keys, i), utils.PageSize)
+				if err != nil {
+					return err
+				}
+				defer result.Close()
+
+				for j := 0; result.Next() && j < utils.PageSize; j++ {
+					err = result.Scan(
+						&booksPage[j].ID,
+						&booksPage[j].ISBN,
+						&booksPage[j].Title,
+						&booksPage[j].Author,
+						&booksPage[j].Genre,
+						&booksPage[j].Quantity,
+						&booksPage[j].PublicizedAt,
+					)
+					if err != nil {
+						return err
+					}
+				}
+
+				return result.Err()
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
-func (p *PgxBenchmark) FindByID(b *testing.B) {
-	book := model.NewBook()
-	savedIDs := make([]int64, b.N)
-	for i := 0; i < b.N; i++ {
-		var id int64
-		err := p.db.QueryRow(p.ctx, utils.InsertReturningIDQuery,
-			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
-		if err != nil {
-			b.Error(err)
+func (p *PgxBenchmark[K]) FindWithActivePolicy(b *testing.B) {
+	now := time.Now().UTC()
+	keys, err := seedPricedBooks[K](b.N, now)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		var booksPage []model.Book[K]
+		var policies []model.PricePolicy[K]
+		return step{
+			prepare: func(int) {
+				booksPage = make([]model.Book[K], utils.PageSize)
+				policies = make([]model.PricePolicy[K], utils.PageSize)
+			},
+			exec: func(i int) error {
+				result, err := p.db.Query(p.ctx, utils.SelectJoinQuery, now, cursorAt(keys, i), utils.PageSize)
+				if err != nil {
+					return err
+				}
+				defer result.Close()
+
+				for j := 0; result.Next() && j < utils.PageSize; j++ {
+					booksPage[j].ActivePolicy = &policies[j]
+					err = result.Scan(
+						&booksPage[j].ID,
+						&booksPage[j].ISBN,
+						&booksPage[j].Title,
+						&booksPage[j].Author,
+						&booksPage[j].Genre,
+						&booksPage[j].Quantity,
+						&booksPage[j].PublicizedAt,
+						&policies[j].ID,
+						&policies[j].BookID,
+						&policies[j].Price,
+						&policies[j].StartDate,
+						&policies[j].EndDate,
+					)
+					if err != nil {
+						return err
+					}
+				}
+
+				return result.Err()
+			},
 		}
-		savedIDs[i] = id
+	})
+}
+
+func (p *PgxBenchmark[K]) FindPageWithPolicies(strategy LoadStrategy) func(b *testing.B) {
+	switch strategy {
+	case NPlusOne:
+		return eagerBenchmark(func(cursor K) error {
+			books, err := p.findPage(cursor)
+			if err != nil {
+				return err
+			}
+			for j := range books {
+				books[j].Policies, err = p.findPolicies(utils.SelectPoliciesByBookQuery, books[j].ID)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	case Preload:
+		return eagerBenchmark(func(cursor K) error {
+			books, err := p.findPage(cursor)
+			if err != nil {
+				return err
+			}
+			ids := make([]K, len(books))
+			for j := range books {
+				ids[j] = books[j].ID
+			}
+			policies, err := p.findPolicies(utils.SelectPoliciesByBooksQuery, ids)
+			if err != nil {
+				return err
+			}
+			attachPolicies(books, policies)
+			return nil
+		})
+	case Join:
+		return eagerBenchmark(func(cursor K) error {
+			rows, err := p.db.Query(p.ctx, utils.SelectEagerJoinQuery, cursor, utils.PageSize)
+			if err != nil {
+				return err
+			}
+			joined, err := pgx.CollectRows(rows, pgx.RowToStructByPos[bookPolicyRow[K]])
+			if err != nil {
+				return err
+			}
+			_ = groupPolicies(joined)
+			return nil
+		})
 	}
+	return nil
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+func (p *PgxBenchmark[K]) findPage(cursor K) ([]model.Book[K], error) {
+	return p.findBooks(utils.PageSize, utils.SelectPaginatingQuery, cursor, utils.PageSize)
+}
 
-	var bookID int64
-	var foundBook model.Book
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		bookID = savedIDs[i]
-		foundBook = model.Book{}
-		b.StartTimer()
-
-		err := p.db.QueryRow(p.ctx, utils.SelectByIDQuery, bookID).Scan(
-			&foundBook.ID,
-			&foundBook.ISBN,
-			&foundBook.Title,
-			&foundBook.Author,
-			&foundBook.Genre,
-			&foundBook.Quantity,
-			&foundBook.PublicizedAt,
-		)
-
-		b.StopTimer()
+// findBooks runs the query, expecting about size books.
+func (p *PgxBenchmark[K]) findBooks(size int, query string, args ...interface{}) ([]model.Book[K], error) {
+	rows, err := p.db.Query(p.ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := make([]model.Book[K], 0, size)
+	for rows.Next() {
+		var book model.Book[K]
+		err = rows.Scan(&book.ID, &book.ISBN, &book.Title, &book.Author, &book.Genre, &book.Quantity, &book.PublicizedAt)
 		if err != nil {
-			b.Error(err)
+			return nil, err
 		}
-		b.StartTimer()
+		books = append(books, book)
 	}
+	return books, rows.Err()
 }
 
-func (p *PgxBenchmark) FindPage(b *testing.B) {
-	n := b.N
-	var rows = make([][]interface{}, 0)
-	for _, book := range model.NewBooks(n) {
-		rows = append(rows, []interface{}{book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt})
+func (p *PgxBenchmark[K]) findPolicies(query string, args ...interface{}) ([]*model.PricePolicy[K], error) {
+	rows, err := p.db.Query(p.ctx, query, args...)
+	if err != nil {
+		return nil, err
 	}
+	return pgx.CollectRows(rows, pgx.RowToAddrOfStructByPos[model.PricePolicy[K]])
+}
 
-	_, err := p.db.CopyFrom(p.ctx, pgx.Identifier{"books"}, columns, pgx.CopyFromRows(rows))
-	if err != nil {
-		b.Error(err)
+func (p *PgxBenchmark[K]) Transaction(b *testing.B) {
+	p.transaction(b, false)
+}
+
+func (p *PgxBenchmark[K]) TransactionRollback(b *testing.B) {
+	p.transaction(b, true)
+}
+
+func (p *PgxBenchmark[K]) transaction(b *testing.B, rollback bool) {
+	now := time.Now().UTC()
+	book := model.NewBook[K]()
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				tx, err := p.db.Begin(p.ctx)
+				if err != nil {
+					return err
+				}
+				// Rolling back a committed transaction is a no-op.
+				defer func() {
+					_ = tx.Rollback(p.ctx)
+				}()
+
+				var id K
+				err = tx.QueryRow(p.ctx, utils.InsertReturningIDQuery,
+					book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
+				if err != nil {
+					return err
+				}
+
+				prices, startDates, endDates := policyColumns(model.NewPricePolicies(id, now))
+				_, err = tx.Exec(p.ctx, utils.InsertPricePoliciesQuery, id, prices, startDates, endDates)
+				if err != nil {
+					return err
+				}
+
+				if rollback {
+					return tx.Rollback(p.ctx)
+				}
+				return tx.Commit(p.ctx)
+			},
+		}
+	})
+}
+
+func (p *PgxBenchmark[K]) Upsert(b *testing.B) {
+	upsertBenchmark(b, func(books []*model.Book[K]) error {
+		isbns, titles, authors, genres, quantities, publicizedAts := bookColumns(books)
+		_, err := p.db.Exec(p.ctx, utils.UpsertArraysQuery, isbns, titles, authors, genres, quantities, publicizedAts)
+		return err
+	})
+}
+
+func (p *PgxBenchmark[K]) UpdateBulk(affected int) func(b *testing.B) {
+	return updateBulkBenchmark(affected, func(genre string, quantity int) error {
+		_, err := p.db.Exec(p.ctx, utils.UpdateByGenreQuery, quantity, genre)
+		return err
+	})
+}
+
+func (p *PgxBenchmark[K]) DeleteBulk(affected int) func(b *testing.B) {
+	return deleteBulkBenchmark(affected, func(genre string) error {
+		_, err := p.db.Exec(p.ctx, utils.DeleteByGenreQuery, genre)
+		return err
+	})
+}
+
+func (p *PgxBenchmark[K]) FindByIDs(list IDList) func(b *testing.B) {
+	switch list {
+	case InList:
+		return manyIDsBenchmark(func(ids []K) error {
+			query, args := selectByIDsInQuery(ids)
+			_, err := p.findBooks(len(ids), query, args...)
+			return err
+		})
+	case AnyArray:
+		return manyIDsBenchmark(func(ids []K) error {
+			_, err := p.findBooks(len(ids), utils.SelectByIDsAnyQuery, ids)
+			return err
+		})
 	}
+	return nil
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+func (p *PgxBenchmark[K]) Aggregate(b *testing.B) {
+	aggregateBenchmark(b, func() error {
+		rows, err := p.db.Query(p.ctx, utils.SelectGenreStatsQuery)
+		if err != nil {
+			return err
+		}
+		_, err = pgx.CollectRows(rows, pgx.RowToStructByPos[genreStats])
+		return err
+	})
+}
 
-	for i := 0; i < n; i++ {
-		b.StopTimer()
-		booksPage := make([]model.Book, utils.PageSize)
-		b.StartTimer()
+func (p *PgxBenchmark[K]) Search(b *testing.B) {
+	searchBenchmark(b, func(f searchFilter) error {
+		query, args := searchQuery(f)
+		_, err := p.findBooks(utils.PageSize, query, args...)
+		return err
+	})
+}
 
-		result, err := p.db.Query(p.ctx, utils.SelectPaginatingQuery, i, utils.PageSize)
+func (p *PgxBenchmark[K]) FindPageAt(pagination Pagination, depth int) func(b *testing.B) {
+	return paginationBenchmark(depth, func(depth int, cursor K) error {
+		var err error
+		if pagination == Offset {
+			_, err = p.findBooks(utils.PageSize, utils.SelectOffsetQuery, depth, utils.PageSize)
+		} else {
+			_, err = p.findPage(cursor)
+		}
+		return err
+	})
+}
 
-		b.StopTimer()
+func (p *PgxBenchmark[K]) FindTitles(b *testing.B) {
+	projectionBenchmark(b, func(cursor K) error {
+		rows, err := p.db.Query(p.ctx, utils.SelectTitlesQuery, cursor, utils.PageSize)
 		if err != nil {
-			b.Error(err)
+			return err
 		}
-		b.StartTimer()
-
-		for j := 0; result.Next() && j < utils.PageSize; j++ {
-			err = result.Scan(
-				&booksPage[j].ID,
-				&booksPage[j].ISBN,
-				&booksPage[j].Title,
-				&booksPage[j].Author,
-				&booksPage[j].Genre,
-				&booksPage[j].Quantity,
-				&booksPage[j].PublicizedAt,
-			)
-			b.StopTimer()
+		_, err = pgx.CollectRows(rows, pgx.RowToStructByPos[bookTitle[K]])
+		return err
+	})
+}
+
+func (p *PgxBenchmark[K]) ScanLarge(mode ScanMode) func(b *testing.B) {
+	switch mode {
+	case Buffered:
+		return scanLargeBenchmark(func() error {
+			_, err := p.findBooks(ScanLargeRows, utils.SelectAllQuery)
+			return err
+		})
+	case Streaming:
+		return scanLargeBenchmark(func() error {
+			rows, err := p.db.Query(p.ctx, utils.SelectAllQuery)
 			if err != nil {
-				b.Error(err)
+				return err
+			}
+			defer rows.Close()
+
+			var book model.Book[K]
+			for rows.Next() {
+				err = rows.Scan(&book.ID, &book.ISBN, &book.Title, &book.Author, &book.Genre, &book.Quantity, &book.PublicizedAt)
+				if err != nil {
+					return err
+				}
 			}
-			b.StartTimer()
+			return rows.Err()
+		})
+	}
+	return nil
+}
+
+func (p *PgxBenchmark[K]) InsertNullable(b *testing.B) {
+	insertNullableBenchmark(b, func(book *model.NullableBook[K]) error {
+		_, err := p.db.Exec(p.ctx, utils.InsertNullableQuery, nullableBookValues(book)...)
+		return err
+	})
+}
+
+func (p *PgxBenchmark[K]) FindNullable(b *testing.B) {
+	findNullableBenchmark(b, func(cursor K) error {
+		rows, err := p.db.Query(p.ctx, utils.SelectNullablePaginatingQuery, cursor, utils.PageSize)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		books := make([]model.NullableBook[K], 0, utils.PageSize)
+		for rows.Next() {
+			var book model.NullableBook[K]
+			err = rows.Scan(&book.ID, &book.ISBN, &book.Title, &book.Subtitle, &book.Description, &book.Author,
+				&book.Genre, &book.Quantity, &book.PublicizedAt, &book.DiscontinuedAt)
+			if err != nil {
+				return err
+			}
+			books = append(books, book)
+		}
+		return rows.Err()
+	})
+}
+
+func (p *PgxBenchmark[K]) UpdateNullable(b *testing.B) {
+	updateNullableBenchmark(b, func(book *model.NullableBook[K]) error {
+		_, err := p.db.Exec(p.ctx, utils.UpdateNullableQuery, append(nullableBookValues(book), book.ID)...)
+		return err
+	})
+}
+
+// InsertJSON passes the metadata as is, as pgx encodes and decodes JSONB columns with encoding/json.
+func (p *PgxBenchmark[K]) InsertJSON(size DocumentSize) func(b *testing.B) {
+	return insertJSONBenchmark(size, func(book *model.JSONBook[K]) error {
+		_, err := p.db.Exec(p.ctx, utils.InsertJSONQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt, book.Metadata)
+		return err
+	})
+}
+
+func (p *PgxBenchmark[K]) FindJSON(size DocumentSize) func(b *testing.B) {
+	return findJSONBenchmark(size, func(cursor K) error {
+		rows, err := p.db.Query(p.ctx, utils.SelectJSONPaginatingQuery, cursor, utils.PageSize)
+		if err != nil {
+			return err
+		}
+		_, err = pgx.CollectRows(rows, pgx.RowToStructByPos[model.JSONBook[K]])
+		return err
+	})
+}
+
+// InsertTags passes the tags as a []string, which pgx encodes as a native array.
+func (p *PgxBenchmark[K]) InsertTags(b *testing.B) {
+	insertTagsBenchmark(b, func(book *model.TaggedBook[K]) error {
+		_, err := p.db.Exec(p.ctx, utils.InsertTaggedQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt, []string(book.Tags))
+		return err
+	})
+}
+
+func (p *PgxBenchmark[K]) FindTags(b *testing.B) {
+	findTagsBenchmark(b, func(cursor K) error {
+		_, err := p.findTaggedBooks(utils.SelectTaggedPaginatingQuery, cursor, utils.PageSize)
+		return err
+	})
+}
+
+func (p *PgxBenchmark[K]) FilterTags(b *testing.B) {
+	filterTagsBenchmark[K](b, func(tags []string) error {
+		_, err := p.findTaggedBooks(utils.SelectByTagsQuery, tags, utils.PageSize)
+		return err
+	})
+}
+
+// findTaggedBooks scans the tags into a []string, so pgx decodes them natively instead of going
+// through the sql.Scanner of pq.StringArray.
+func (p *PgxBenchmark[K]) findTaggedBooks(query string, args ...interface{}) ([]model.TaggedBook[K], error) {
+	rows, err := p.db.Query(p.ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	books := make([]model.TaggedBook[K], 0, utils.PageSize)
+	for rows.Next() {
+		var book model.TaggedBook[K]
+		err = rows.Scan(&book.ID, &book.ISBN, &book.Title, &book.Author, &book.Genre, &book.Quantity, &book.PublicizedAt,
+			(*[]string)(&book.Tags))
+		if err != nil {
+			return nil, err
 		}
+		books = append(books, book)
 	}
+	return books, rows.Err()
 }
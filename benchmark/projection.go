@@ -0,0 +1,31 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// bookTitle is the projection of a book on its id and title.
+type bookTitle[K model.Key] struct {
+	ID    K
+	Title string
+}
+
+// projectionBenchmark seeds the books, then measures project fetching the id and title of the
+// page after the cursor of the iteration, like FindPage does with whole books.
+func projectionBenchmark[K model.Key](b *testing.B, project func(cursor K) error) {
+	keys, err := seedKeyedBooks[K](b.N)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				return project(cursorAt(keys, i))
+			},
+		}
+	})
+}
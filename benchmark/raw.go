@@ -1,220 +1,655 @@
 package benchmark
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
 	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
-type RawBenchmark struct {
+type RawBenchmark[K model.Key] struct {
 	db *sql.DB
 }
 
 func NewRawBenchmark() Benchmark {
-	return &RawBenchmark{}
+	if utils.PrimaryKey == utils.UUIDKey {
+		return &RawBenchmark[uuid.UUID]{}
+	}
+	return &RawBenchmark[int64]{}
 }
 
-func (r *RawBenchmark) Init() error {
+func (r *RawBenchmark[K]) Init() error {
 	var err error
 	r.db, err = sql.Open("pgx", utils.PostgresDSN)
-	return err
+	if err != nil {
+		return err
+	}
+	utils.ConfigurePool(r.db)
+	return nil
 }
 
-func (r *RawBenchmark) Close() error {
+func (r *RawBenchmark[K]) Close() error {
 	return r.db.Close()
 }
 
-func (r *RawBenchmark) Insert(b *testing.B) {
-	book := model.NewBook()
+func (r *RawBenchmark[K]) Insert(b *testing.B) {
+	book := model.NewBook[K]()
 
-	b.ReportAllocs()
-	b.ResetTimer()
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				_, err := r.db.Exec(utils.InsertQuery,
+					book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+				return err
+			},
+		}
+	})
+}
 
-	for i := 0; i < b.N; i++ {
-		_, err := r.db.Exec(utils.InsertQuery,
-			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt)
+func (r *RawBenchmark[K]) InsertBulk(b *testing.B) {
+	books := model.NewBooks[K](utils.BulkInsertNumber)
 
-		b.StopTimer()
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				return r.doInsertBulk(books)
+			},
+		}
+	})
+}
+
+func (r *RawBenchmark[K]) Update(b *testing.B) {
+	run(b, func() step {
+		book := model.NewBook[K]()
+		var id K
+		err := r.db.QueryRow(utils.InsertReturningIDQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
 		if err != nil {
 			b.Error(err)
+			return step{exec: func(int) error { return err }}
 		}
-		b.StartTimer()
-	}
+		return step{
+			exec: func(int) error {
+				_, err := r.db.Exec(utils.UpdateQuery,
+					book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt, id)
+				return err
+			},
+		}
+	})
 }
 
-func (r *RawBenchmark) InsertBulk(b *testing.B) {
-	books := model.NewBooks(utils.BulkInsertNumber)
+func (r *RawBenchmark[K]) Delete(b *testing.B) {
+	n := b.N
+	book := model.NewBook[K]()
+	bookIDs := make([]K, 0, n)
+	for i := 0; i < n; i++ {
+		var id K
+		err := r.db.QueryRow(utils.InsertReturningIDQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
+		if err != nil {
+			b.Error(err)
+		}
+		bookIDs = append(bookIDs, id)
+	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := r.db.Exec(utils.DeleteQuery, bookIDs[i])
+				return err
+			},
+		}
+	})
+}
 
+func (r *RawBenchmark[K]) FindByID(b *testing.B) {
+	book := model.NewBook[K]()
+	savedIDs := make([]K, b.N)
 	for i := 0; i < b.N; i++ {
-		err := r.doInsertBulk(books)
-
-		b.StopTimer()
+		var id K
+		err := r.db.QueryRow(utils.InsertReturningIDQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
 		if err != nil {
 			b.Error(err)
 		}
-		b.StartTimer()
+		savedIDs[i] = id
 	}
+
+	run(b, func() step {
+		var foundBook model.Book[K]
+		return step{
+			prepare: func(int) {
+				foundBook = model.Book[K]{}
+			},
+			exec: func(i int) error {
+				return r.db.QueryRow(utils.SelectByIDQuery, savedIDs[i]).Scan(
+					&foundBook.ID,
+					&foundBook.ISBN,
+					&foundBook.Title,
+					&foundBook.Author,
+					&foundBook.Genre,
+					&foundBook.Quantity,
+					&foundBook.PublicizedAt,
+				)
+			},
+		}
+	})
 }
 
-func (r *RawBenchmark) Update(b *testing.B) {
-	book := model.NewBook()
-	var id int64
-	err := r.db.QueryRow(utils.InsertReturningIDQuery,
-		book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
+func (r *RawBenchmark[K]) FindPage(b *testing.B) {
+	keys, err := seedKeyedBooks[K](b.N)
 	if err != nil {
 		b.Error(err)
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+	run(b, func() step {
+		var booksPage []model.Book[K]
+		return step{
+			prepare: func(int) {
+				booksPage = make([]model.Book[K], utils.PageSize)
+			},
+			exec: func(i int) error {
+				rows, err := r.db.Query(utils.SelectPaginatingQuery, cursorAt(keys, i), utils.PageSize)
+				if err != nil {
+					return err
+				}
+
+				for j := 0; rows.Next() && j < utils.PageSize; j++ {
+					err = rows.Scan(
+						&booksPage[j].ID,
+						&booksPage[j].ISBN,
+						&booksPage[j].Title,
+						&booksPage[j].Author,
+						&booksPage[j].Genre,
+						&booksPage[j].Quantity,
+						&booksPage[j].PublicizedAt,
+					)
+					if err != nil {
+						_ = rows.Close()
+						return err
+					}
+				}
+
+				return rows.Close()
+			},
+		}
+	})
+}
 
-	for i := 0; i < b.N; i++ {
-		_, err = r.db.Exec(utils.UpdateQuery,
-			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt, id)
+func (r *RawBenchmark[K]) FindWithActivePolicy(b *testing.B) {
+	now := time.Now().UTC()
+	keys, err := seedPricedBooks[K](b.N, now)
+	if err != nil {
+		b.Error(err)
+		return
+	}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		var booksPage []model.Book[K]
+		var policies []model.PricePolicy[K]
+		return step{
+			prepare: func(int) {
+				booksPage = make([]model.Book[K], utils.PageSize)
+				policies = make([]model.PricePolicy[K], utils.PageSize)
+			},
+			exec: func(i int) error {
+				rows, err := r.db.Query(utils.SelectJoinQuery, now, cursorAt(keys, i), utils.PageSize)
+				if err != nil {
+					return err
+				}
+
+				for j := 0; rows.Next() && j < utils.PageSize; j++ {
+					booksPage[j].ActivePolicy = &policies[j]
+					err = rows.Scan(
+						&booksPage[j].ID,
+						&booksPage[j].ISBN,
+						&booksPage[j].Title,
+						&booksPage[j].Author,
+						&booksPage[j].Genre,
+						&booksPage[j].Quantity,
+						&booksPage[j].PublicizedAt,
+						&policies[j].ID,
+						&policies[j].BookID,
+						&policies[j].Price,
+						&policies[j].StartDate,
+						&policies[j].EndDate,
+					)
+					if err != nil {
+						_ = rows.Close()
+						return err
+					}
+				}
+
+				return rows.Close()
+			},
 		}
-		b.StartTimer()
+	})
+}
+
+func (r *RawBenchmark[K]) FindPageWithPolicies(strategy LoadStrategy) func(b *testing.B) {
+	switch strategy {
+	case NPlusOne:
+		return eagerBenchmark(func(cursor K) error {
+			books, err := r.findPage(cursor)
+			if err != nil {
+				return err
+			}
+			for j := range books {
+				books[j].Policies, err = r.findPolicies(utils.SelectPoliciesByBookQuery, books[j].ID)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	case Preload:
+		return eagerBenchmark(func(cursor K) error {
+			books, err := r.findPage(cursor)
+			if err != nil {
+				return err
+			}
+			ids := make([]K, len(books))
+			for j := range books {
+				ids[j] = books[j].ID
+			}
+			policies, err := r.findPolicies(utils.SelectPoliciesByBooksQuery, ids)
+			if err != nil {
+				return err
+			}
+			attachPolicies(books, policies)
+			return nil
+		})
+	case Join:
+		return eagerBenchmark(func(cursor K) error {
+			_, err := r.findPageJoined(cursor)
+			return err
+		})
 	}
+	return nil
 }
 
-func (r *RawBenchmark) Delete(b *testing.B) {
-	n := b.N
-	book := model.NewBook()
-	bookIDs := make([]int64, 0, n)
-	for i := 0; i < n; i++ {
-		var id int64
-		err := r.db.QueryRow(utils.InsertReturningIDQuery,
-			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
-		if err != nil {
-			b.Error(err)
+func (r *RawBenchmark[K]) Transaction(b *testing.B) {
+	r.transaction(b, false)
+}
+
+func (r *RawBenchmark[K]) TransactionRollback(b *testing.B) {
+	r.transaction(b, true)
+}
+
+func (r *RawBenchmark[K]) transaction(b *testing.B, rollback bool) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	book := model.NewBook[K]()
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				tx, err := r.db.BeginTx(ctx, nil)
+				if err != nil {
+					return err
+				}
+				// Rolling back a committed transaction is a no-op.
+				defer func() {
+					_ = tx.Rollback()
+				}()
+
+				var id K
+				err = tx.QueryRowContext(ctx, utils.InsertReturningIDQuery,
+					book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
+				if err != nil {
+					return err
+				}
+
+				prices, startDates, endDates := policyColumns(model.NewPricePolicies(id, now))
+				_, err = tx.ExecContext(ctx, utils.InsertPricePoliciesQuery, id, prices, startDates, endDates)
+				if err != nil {
+					return err
+				}
+
+				if rollback {
+					return tx.Rollback()
+				}
+				return tx.Commit()
+			},
 		}
-		bookIDs = append(bookIDs, id)
-	}
+	})
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+func (r *RawBenchmark[K]) Upsert(b *testing.B) {
+	upsertBenchmark(b, func(books []*model.Book[K]) error {
+		return r.execBulk(utils.UpsertBulkQuery, books)
+	})
+}
 
-	var bookID int64
-	for i := 0; i < n; i++ {
-		b.StopTimer()
-		bookID = bookIDs[i]
-		b.StartTimer()
+func (r *RawBenchmark[K]) UpdateBulk(affected int) func(b *testing.B) {
+	return updateBulkBenchmark(affected, func(genre string, quantity int) error {
+		_, err := r.db.Exec(utils.UpdateByGenreQuery, quantity, genre)
+		return err
+	})
+}
 
-		_, err := r.db.Exec(utils.DeleteQuery, bookID)
+func (r *RawBenchmark[K]) DeleteBulk(affected int) func(b *testing.B) {
+	return deleteBulkBenchmark(affected, func(genre string) error {
+		_, err := r.db.Exec(utils.DeleteByGenreQuery, genre)
+		return err
+	})
+}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
-		}
-		b.StartTimer()
+func (r *RawBenchmark[K]) FindByIDs(list IDList) func(b *testing.B) {
+	switch list {
+	case InList:
+		return manyIDsBenchmark(func(ids []K) error {
+			query, args := selectByIDsInQuery(ids)
+			_, err := r.findBooks(len(ids), query, args...)
+			return err
+		})
+	case AnyArray:
+		return manyIDsBenchmark(func(ids []K) error {
+			_, err := r.findBooks(len(ids), utils.SelectByIDsAnyQuery, ids)
+			return err
+		})
 	}
+	return nil
 }
 
-func (r *RawBenchmark) FindByID(b *testing.B) {
-	book := model.NewBook()
-	savedIDs := make([]int64, b.N)
-	for i := 0; i < b.N; i++ {
-		var id int64
-		err := r.db.QueryRow(utils.InsertReturningIDQuery,
-			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt).Scan(&id)
+func (r *RawBenchmark[K]) Aggregate(b *testing.B) {
+	aggregateBenchmark(b, func() error {
+		rows, err := r.db.Query(utils.SelectGenreStatsQuery)
 		if err != nil {
-			b.Error(err)
+			return err
 		}
-		savedIDs[i] = id
-	}
+		defer func() {
+			_ = rows.Close()
+		}()
+
+		var stats []genreStats
+		for rows.Next() {
+			var row genreStats
+			if err = rows.Scan(&row.Genre, &row.Books, &row.TotalQuantity, &row.AverageQuantity); err != nil {
+				return err
+			}
+			stats = append(stats, row)
+		}
+		return rows.Err()
+	})
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+func (r *RawBenchmark[K]) Search(b *testing.B) {
+	searchBenchmark(b, func(f searchFilter) error {
+		query, args := searchQuery(f)
+		_, err := r.findBooks(utils.PageSize, query, args...)
+		return err
+	})
+}
 
-	var bookID int64
-	var foundBook model.Book
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		bookID = savedIDs[i]
-		foundBook = model.Book{}
-		b.StartTimer()
-
-		err := r.db.QueryRow(utils.SelectByIDQuery, bookID).Scan(
-			&foundBook.ID,
-			&foundBook.ISBN,
-			&foundBook.Title,
-			&foundBook.Author,
-			&foundBook.Genre,
-			&foundBook.Quantity,
-			&foundBook.PublicizedAt,
-		)
+func (r *RawBenchmark[K]) FindPageAt(pagination Pagination, depth int) func(b *testing.B) {
+	return paginationBenchmark(depth, func(depth int, cursor K) error {
+		var err error
+		if pagination == Offset {
+			_, err = r.findBooks(utils.PageSize, utils.SelectOffsetQuery, depth, utils.PageSize)
+		} else {
+			_, err = r.findPage(cursor)
+		}
+		return err
+	})
+}
 
-		b.StopTimer()
+func (r *RawBenchmark[K]) FindTitles(b *testing.B) {
+	projectionBenchmark(b, func(cursor K) error {
+		rows, err := r.db.Query(utils.SelectTitlesQuery, cursor, utils.PageSize)
 		if err != nil {
-			b.Error(err)
+			return err
 		}
-		b.StartTimer()
-	}
+		defer func() {
+			_ = rows.Close()
+		}()
+
+		titles := make([]bookTitle[K], 0, utils.PageSize)
+		for rows.Next() {
+			var title bookTitle[K]
+			if err = rows.Scan(&title.ID, &title.Title); err != nil {
+				return err
+			}
+			titles = append(titles, title)
+		}
+		return rows.Err()
+	})
 }
 
-func (r *RawBenchmark) FindPage(b *testing.B) {
-	n := b.N
-	books := model.NewBooks(n)
-	batches := model.Chunk(books, utils.BatchSize)
-	for _, batch := range batches {
-		if err := r.doInsertBulk(batch); err != nil {
-			b.Error(err)
-		}
+func (r *RawBenchmark[K]) ScanLarge(mode ScanMode) func(b *testing.B) {
+	switch mode {
+	case Buffered:
+		return scanLargeBenchmark(func() error {
+			_, err := r.findBooks(ScanLargeRows, utils.SelectAllQuery)
+			return err
+		})
+	case Streaming:
+		return scanLargeBenchmark(func() error {
+			rows, err := r.db.Query(utils.SelectAllQuery)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = rows.Close()
+			}()
+
+			var book model.Book[K]
+			for rows.Next() {
+				err = rows.Scan(&book.ID, &book.ISBN, &book.Title, &book.Author, &book.Genre, &book.Quantity, &book.PublicizedAt)
+				if err != nil {
+					return err
+				}
+			}
+			return rows.Err()
+		})
 	}
+	return nil
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+func (r *RawBenchmark[K]) InsertNullable(b *testing.B) {
+	insertNullableBenchmark(b, func(book *model.NullableBook[K]) error {
+		_, err := r.db.Exec(utils.InsertNullableQuery, nullableBookValues(book)...)
+		return err
+	})
+}
 
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		booksPage := make([]model.Book, utils.PageSize)
-		b.StartTimer()
+func (r *RawBenchmark[K]) FindNullable(b *testing.B) {
+	findNullableBenchmark(b, func(cursor K) error {
+		rows, err := r.db.Query(utils.SelectNullablePaginatingQuery, cursor, utils.PageSize)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = rows.Close()
+		}()
+
+		books := make([]model.NullableBook[K], 0, utils.PageSize)
+		for rows.Next() {
+			var book model.NullableBook[K]
+			err = rows.Scan(&book.ID, &book.ISBN, &book.Title, &book.Subtitle, &book.Description, &book.Author,
+				&book.Genre, &book.Quantity, &book.PublicizedAt, &book.DiscontinuedAt)
+			if err != nil {
+				return err
+			}
+			books = append(books, book)
+		}
+		return rows.Err()
+	})
+}
 
-		rows, err := r.db.Query(utils.SelectPaginatingQuery, i, utils.PageSize)
+func (r *RawBenchmark[K]) UpdateNullable(b *testing.B) {
+	updateNullableBenchmark(b, func(book *model.NullableBook[K]) error {
+		_, err := r.db.Exec(utils.UpdateNullableQuery, append(nullableBookValues(book), book.ID)...)
+		return err
+	})
+}
 
-		b.StopTimer()
+// InsertJSON marshals the metadata itself, as database/sql only passes driver values.
+func (r *RawBenchmark[K]) InsertJSON(size DocumentSize) func(b *testing.B) {
+	return insertJSONBenchmark(size, func(book *model.JSONBook[K]) error {
+		metadata, err := json.Marshal(book.Metadata)
 		if err != nil {
-			b.Error(err)
+			return err
 		}
-		b.StartTimer()
-
-		for j := 0; rows.Next() && j < utils.PageSize; j++ {
-			err = rows.Scan(
-				&booksPage[j].ID,
-				&booksPage[j].ISBN,
-				&booksPage[j].Title,
-				&booksPage[j].Author,
-				&booksPage[j].Genre,
-				&booksPage[j].Quantity,
-				&booksPage[j].PublicizedAt,
-			)
-			b.StopTimer()
+		_, err = r.db.Exec(utils.InsertJSONQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt, metadata)
+		return err
+	})
+}
+
+// FindJSON scans the metadata into bytes and unmarshals them, as database/sql only scans driver values.
+func (r *RawBenchmark[K]) FindJSON(size DocumentSize) func(b *testing.B) {
+	return findJSONBenchmark(size, func(cursor K) error {
+		rows, err := r.db.Query(utils.SelectJSONPaginatingQuery, cursor, utils.PageSize)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = rows.Close()
+		}()
+
+		books := make([]model.JSONBook[K], 0, utils.PageSize)
+		var metadata []byte
+		for rows.Next() {
+			var book model.JSONBook[K]
+			err = rows.Scan(&book.ID, &book.ISBN, &book.Title, &book.Author, &book.Genre, &book.Quantity, &book.PublicizedAt, &metadata)
 			if err != nil {
-				b.Error(err)
+				return err
+			}
+			if err = json.Unmarshal(metadata, &book.Metadata); err != nil {
+				return err
 			}
-			b.StartTimer()
+			books = append(books, book)
+		}
+		return rows.Err()
+	})
+}
+
+func (r *RawBenchmark[K]) InsertTags(b *testing.B) {
+	insertTagsBenchmark(b, func(book *model.TaggedBook[K]) error {
+		_, err := r.db.Exec(utils.InsertTaggedQuery,
+			book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt, book.Tags)
+		return err
+	})
+}
+
+func (r *RawBenchmark[K]) FindTags(b *testing.B) {
+	findTagsBenchmark(b, func(cursor K) error {
+		_, err := r.findTaggedBooks(utils.SelectTaggedPaginatingQuery, cursor, utils.PageSize)
+		return err
+	})
+}
+
+func (r *RawBenchmark[K]) FilterTags(b *testing.B) {
+	filterTagsBenchmark[K](b, func(tags []string) error {
+		_, err := r.findTaggedBooks(utils.SelectByTagsQuery, pq.StringArray(tags), utils.PageSize)
+		return err
+	})
+}
+
+func (r *RawBenchmark[K]) findPage(cursor K) ([]model.Book[K], error) {
+	return r.findBooks(utils.PageSize, utils.SelectPaginatingQuery, cursor, utils.PageSize)
+}
+
+// findBooks runs the query, expecting about size books.
+func (r *RawBenchmark[K]) findBooks(size int, query string, args ...interface{}) ([]model.Book[K], error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	books := make([]model.Book[K], 0, size)
+	for rows.Next() {
+		var book model.Book[K]
+		err = rows.Scan(&book.ID, &book.ISBN, &book.Title, &book.Author, &book.Genre, &book.Quantity, &book.PublicizedAt)
+		if err != nil {
+			return nil, err
 		}
+		books = append(books, book)
+	}
+	return books, rows.Err()
+}
 
-		b.StopTimer()
-		err = rows.Close()
+func (r *RawBenchmark[K]) findPageJoined(cursor K) ([]model.Book[K], error) {
+	rows, err := r.db.Query(utils.SelectEagerJoinQuery, cursor, utils.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var joined []bookPolicyRow[K]
+	for rows.Next() {
+		var row bookPolicyRow[K]
+		err = rows.Scan(
+			&row.ID,
+			&row.ISBN,
+			&row.Title,
+			&row.Author,
+			&row.Genre,
+			&row.Quantity,
+			&row.PublicizedAt,
+			&row.PolicyID,
+			&row.Price,
+			&row.StartDate,
+			&row.EndDate,
+		)
 		if err != nil {
-			b.Error(err)
+			return nil, err
 		}
-		b.StartTimer()
+		joined = append(joined, row)
 	}
+	return groupPolicies(joined), rows.Err()
 }
 
-func (r *RawBenchmark) doInsertBulk(books []*model.Book) error {
+func (r *RawBenchmark[K]) findPolicies(query string, args ...interface{}) ([]*model.PricePolicy[K], error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var policies []*model.PricePolicy[K]
+	for rows.Next() {
+		policy := new(model.PricePolicy[K])
+		err = rows.Scan(&policy.ID, &policy.BookID, &policy.Price, &policy.StartDate, &policy.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, rows.Err()
+}
+
+func (r *RawBenchmark[K]) doInsertBulk(books []*model.Book[K]) error {
+	return r.execBulk(utils.InsertBulkQuery, books)
+}
+
+// execBulk runs the query with the values of the books in place of its %s, in as many statements as
+// the bind parameters of the books require.
+func (r *RawBenchmark[K]) execBulk(query string, books []*model.Book[K]) error {
+	return inStatements(books, func(chunk []*model.Book[K]) error {
+		return r.execStatement(query, chunk)
+	})
+}
+
+// execStatement runs the query with the values of all books in place of its %s.
+func (r *RawBenchmark[K]) execStatement(query string, books []*model.Book[K]) error {
 	valueStrings := make([]string, 0, len(books))
 	valueArgs := make([]interface{}, 0, len(books)*6)
 
@@ -235,9 +670,29 @@ func (r *RawBenchmark) doInsertBulk(books []*model.Book) error {
 		valueArgs = append(valueArgs, book.PublicizedAt)
 	}
 
-	query := fmt.Sprintf(utils.InsertBulkQuery, strings.Join(valueStrings, ","))
-
-	_, err := r.db.Exec(query, valueArgs...)
+	_, err := r.db.Exec(fmt.Sprintf(query, strings.Join(valueStrings, ",")), valueArgs...)
 
 	return err
 }
+
+// findTaggedBooks scans the tags through pq.StringArray, as database/sql only scans driver values.
+func (r *RawBenchmark[K]) findTaggedBooks(query string, args ...interface{}) ([]model.TaggedBook[K], error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	books := make([]model.TaggedBook[K], 0, utils.PageSize)
+	for rows.Next() {
+		var book model.TaggedBook[K]
+		err = rows.Scan(&book.ID, &book.ISBN, &book.Title, &book.Author, &book.Genre, &book.Quantity, &book.PublicizedAt, &book.Tags)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+	return books, rows.Err()
+}
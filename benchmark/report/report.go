@@ -0,0 +1,229 @@
+package report
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/environment"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/stats"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+)
+
+//go:embed report.html
+var source string
+
+var page = template.Must(template.New("report").Parse(source))
+
+// Chart geometry, in SVG user units.
+const (
+	chartWidth = 420
+	labelWidth = 70
+	valueWidth = 130
+	barHeight  = 18
+	barGap     = 8
+	topPadding = 8
+)
+
+// metric extracts a per-operation value from a benchmark run.
+type metric struct {
+	name  string
+	value func(r testing.BenchmarkResult) float64
+}
+
+var metrics = []metric{
+	{"ns/op", func(r testing.BenchmarkResult) float64 {
+		return float64(r.T.Nanoseconds()) / float64(max(r.N, 1))
+	}},
+	{"B/op", func(r testing.BenchmarkResult) float64 {
+		return float64(r.MemBytes) / float64(max(r.N, 1))
+	}},
+	{"allocs/op", func(r testing.BenchmarkResult) float64 {
+		return float64(r.MemAllocs) / float64(max(r.N, 1))
+	}},
+}
+
+type data struct {
+	Title       string
+	Generated   string
+	Config      [][2]string
+	Environment [][2]string
+	Operations  []operation
+	Errors      []string
+}
+
+type operation struct {
+	Name   string
+	Runs   int
+	Charts []chart
+}
+
+type chart struct {
+	Metric string
+	Width  int
+	Height int
+	// LabelWidth is where the bars start.
+	LabelWidth int
+	BarHeight  int
+	Bars       []bar
+}
+
+type bar struct {
+	Orm   string
+	Label string
+	Y     float64
+	TextY float64
+	Width float64
+	// ValueX is where the label goes, past the bar and its error bar.
+	ValueX float64
+	// ErrorBar is set when the operation ran more than once, spanning the 95% confidence interval.
+	ErrorBar                     bool
+	ErrorLow, ErrorHigh          float64
+	ErrorTop, ErrorMid, ErrorBot float64
+	// Lowest marks the best result of the chart.
+	Lowest bool
+}
+
+// Write renders the results as a self-contained HTML page, with a bar chart per operation and
+// metric. Bars show the mean of the runs and, when there are several, their 95% confidence interval.
+func Write(w io.Writer, title string, config utils.Config, env environment.Environment, results []benchmark.ResultWrapper) error {
+	results = slices.Clone(results)
+	slices.SortStableFunc(results, func(a, b benchmark.ResultWrapper) int {
+		return strings.Compare(a.Orm, b.Orm)
+	})
+
+	d := data{
+		Title:       title,
+		Generated:   time.Now().Format(time.RFC1123),
+		Config:      config.Pairs(),
+		Environment: env.Pairs(),
+	}
+	for _, op := range operations(results) {
+		o := operation{Name: op}
+		for _, m := range metrics {
+			c := newChart(m, op, results)
+			if len(c.Bars) > 0 {
+				o.Charts = append(o.Charts, c)
+			}
+		}
+		for _, r := range results {
+			o.Runs = max(o.Runs, len(samples(r, op)))
+		}
+		d.Operations = append(d.Operations, o)
+	}
+	for _, r := range results {
+		if r.Err != nil {
+			d.Errors = append(d.Errors, fmt.Sprintf("%s failed: %v", r.Orm, r.Err))
+		}
+	}
+	return page.Execute(w, d)
+}
+
+func newChart(m metric, op string, results []benchmark.ResultWrapper) chart {
+	type point struct {
+		orm     string
+		summary stats.Summary
+	}
+	var points []point
+	var top float64
+	for _, r := range results {
+		runs := samples(r, op)
+		if len(runs) == 0 {
+			continue
+		}
+		values := make([]float64, len(runs))
+		for i, run := range runs {
+			values[i] = m.value(run)
+		}
+		summary := stats.Summarize(values)
+		points = append(points, point{orm: r.Orm, summary: summary})
+		top = math.Max(top, math.Max(summary.Mean, summary.CIHigh))
+	}
+
+	c := chart{
+		Metric:     m.name,
+		Width:      labelWidth + chartWidth + valueWidth,
+		Height:     topPadding*2 + len(points)*(barHeight+barGap),
+		LabelWidth: labelWidth,
+		BarHeight:  barHeight,
+	}
+	scale := 0.0
+	if top > 0 {
+		scale = chartWidth / top
+	}
+	lowest := math.Inf(1)
+	for _, p := range points {
+		lowest = math.Min(lowest, p.summary.Mean)
+	}
+	for i, p := range points {
+		y := float64(topPadding + i*(barHeight+barGap))
+		b := bar{
+			Orm:    p.orm,
+			Label:  formatValue(p.summary.Mean),
+			Y:      y,
+			TextY:  y + barHeight*0.7,
+			Width:  round(math.Max(p.summary.Mean*scale, 1)),
+			Lowest: p.summary.Mean == lowest,
+		}
+		if p.summary.N > 1 {
+			b.ErrorBar = true
+			b.ErrorLow = round(labelWidth + math.Max(p.summary.CILow, 0)*scale)
+			b.ErrorHigh = round(labelWidth + p.summary.CIHigh*scale)
+			b.ErrorTop = y + 3
+			b.ErrorMid = y + barHeight/2
+			b.ErrorBot = y + barHeight - 3
+			b.Label += " ± " + strconv.FormatFloat(p.summary.CIMargin()*100, 'f', 1, 64) + "%"
+		}
+		b.ValueX = math.Max(labelWidth+b.Width, b.ErrorHigh) + 6
+		c.Bars = append(c.Bars, b)
+	}
+	return c
+}
+
+// samples returns every run of the operation, falling back to the reported one.
+func samples(r benchmark.ResultWrapper, op string) []testing.BenchmarkResult {
+	if runs, ok := r.Samples[op]; ok && len(runs) > 0 {
+		return runs
+	}
+	if result, ok := r.Benchmarks[op]; ok {
+		return []testing.BenchmarkResult{result}
+	}
+	return nil
+}
+
+func operations(results []benchmark.ResultWrapper) []string {
+	var ops []string
+	for _, r := range results {
+		for op := range r.Benchmarks {
+			if !slices.Contains(ops, op) {
+				ops = append(ops, op)
+			}
+		}
+	}
+	slices.Sort(ops)
+	return ops
+}
+
+func formatValue(v float64) string {
+	switch {
+	case v >= 100:
+		return strconv.FormatFloat(v, 'f', 0, 64)
+	case v >= 1:
+		return strconv.FormatFloat(v, 'f', 1, 64)
+	default:
+		return strconv.FormatFloat(v, 'f', 2, 64)
+	}
+}
+
+// round keeps one decimal of the SVG coordinates, which is finer than any screen renders.
+func round(v float64) float64 {
+	return math.Round(v*10) / 10
+}
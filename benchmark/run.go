@@ -0,0 +1,163 @@
+package benchmark
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/histogram"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+)
+
+const (
+	// OpsPerSecMetric is the throughput reported by every benchmark.
+	OpsPerSecMetric = "ops/s"
+	// LatencyMetric is the average time a single goroutine waits for one operation.
+	LatencyMetric = "latency-ns/op"
+	// P50Metric and the following ones are the latency percentiles taken from the histogram.
+	P50Metric  = "p50-ns"
+	P90Metric  = "p90-ns"
+	P99Metric  = "p99-ns"
+	P999Metric = "p99.9-ns"
+	MaxMetric  = "max-ns"
+)
+
+// lastHistogram keeps the latencies recorded by the latest run call. testing.Benchmark
+// calls the benchmark function several times, and only its last round is reported.
+var lastHistogram atomic.Pointer[histogram.Histogram]
+
+// step is a single measured iteration of a benchmark.
+type step struct {
+	// prepare resets the iteration state before exec. It always runs outside the timed
+	// region: in parallel mode, the shared timer only runs while a goroutine is in exec.
+	prepare func(i int)
+	// exec is the measured operation. i is unique in [0, b.N) across all goroutines.
+	exec func(i int) error
+}
+
+// Measure runs f through testing.Benchmark and returns its result along with the
+// latency histogram of the round the result refers to.
+func Measure(f func(b *testing.B)) (testing.BenchmarkResult, *histogram.Histogram) {
+	lastHistogram.Store(nil)
+	result := testing.Benchmark(f)
+	return result, lastHistogram.Load()
+}
+
+// run executes b.N iterations of the step built by newStep, recording the latency of
+// each one. When utils.Parallelism is greater than one, the iterations are spread over
+// that many goroutines, each one owning the step returned by its own newStep call.
+func run(b *testing.B, newStep func() step) {
+	steps := make([]step, utils.Parallelism)
+	latencies := make([]*histogram.Histogram, utils.Parallelism)
+	for i := range steps {
+		steps[i] = newStep()
+		latencies[i] = histogram.New()
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	if len(steps) == 1 {
+		runSerial(b, steps[0], latencies[0])
+	} else {
+		runParallel(b, steps, latencies)
+	}
+
+	b.StopTimer()
+	merged := histogram.New()
+	for _, h := range latencies {
+		merged.Merge(h)
+	}
+	lastHistogram.Store(merged)
+
+	b.ReportMetric(float64(b.N)/b.Elapsed().Seconds(), OpsPerSecMetric)
+	b.ReportMetric(float64(merged.Mean()), LatencyMetric)
+	b.ReportMetric(float64(merged.Percentile(50)), P50Metric)
+	b.ReportMetric(float64(merged.Percentile(90)), P90Metric)
+	b.ReportMetric(float64(merged.Percentile(99)), P99Metric)
+	b.ReportMetric(float64(merged.Percentile(99.9)), P999Metric)
+	b.ReportMetric(float64(merged.Max()), MaxMetric)
+}
+
+func runSerial(b *testing.B, s step, latencies *histogram.Histogram) {
+	for i := 0; i < b.N; i++ {
+		if s.prepare != nil {
+			b.StopTimer()
+			s.prepare(i)
+			b.StartTimer()
+		}
+
+		start := time.Now()
+		err := s.exec(i)
+		latencies.Record(time.Since(start))
+
+		b.StopTimer()
+		if err != nil {
+			b.Error(err)
+		}
+		b.StartTimer()
+	}
+}
+
+func runParallel(b *testing.B, steps []step, latencies []*histogram.Histogram) {
+	var (
+		next  atomic.Int64
+		wg    sync.WaitGroup
+		timer = execTimer{b: b}
+	)
+
+	b.StopTimer()
+	for w := range steps {
+		wg.Add(1)
+		go func(s step, latencies *histogram.Histogram) {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= b.N {
+					return
+				}
+				if s.prepare != nil {
+					s.prepare(i)
+				}
+
+				timer.enter()
+				start := time.Now()
+				err := s.exec(i)
+				latencies.Record(time.Since(start))
+				timer.exit()
+
+				if err != nil {
+					b.Error(err)
+				}
+			}
+		}(steps[w], latencies[w])
+	}
+	wg.Wait()
+}
+
+// execTimer runs the timer of b, which is shared by all goroutines, only while at least
+// one of them is inside exec.
+type execTimer struct {
+	b      *testing.B
+	mu     sync.Mutex
+	active int
+}
+
+func (t *execTimer) enter() {
+	t.mu.Lock()
+	if t.active == 0 {
+		t.b.StartTimer()
+	}
+	t.active++
+	t.mu.Unlock()
+}
+
+func (t *execTimer) exit() {
+	t.mu.Lock()
+	t.active--
+	if t.active == 0 {
+		t.b.StopTimer()
+	}
+	t.mu.Unlock()
+}
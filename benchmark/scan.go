@@ -0,0 +1,48 @@
+package benchmark
+
+import (
+	"testing"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// ScanMode is a way of reading a large result set.
+type ScanMode string
+
+const (
+	// Buffered materializes every row into a slice before returning.
+	Buffered ScanMode = "buffered"
+	// Streaming iterates over the rows, scanning each one into the same book.
+	Streaming ScanMode = "streaming"
+)
+
+// ScanModes lists every way of ScanLarge.
+var ScanModes = []ScanMode{Buffered, Streaming}
+
+// ScanLargeRows is the number of books ScanLarge reads per iteration.
+const ScanLargeRows = 100000
+
+// scanLargeBenchmark seeds ScanLargeRows books, then measures scan reading all of them, reporting
+// the peak heap of the run along with the allocations.
+func scanLargeBenchmark(scan func() error) func(b *testing.B) {
+	return func(b *testing.B) {
+		if err := truncateBooks(); err != nil {
+			b.Error(err)
+			return
+		}
+		if err := seedBooks(model.NewBooks[int64](ScanLargeRows)); err != nil {
+			b.Error(err)
+			return
+		}
+
+		peak := startHeapPeak()
+		run(b, func() step {
+			return step{
+				exec: func(int) error {
+					return scan()
+				},
+			}
+		})
+		b.ReportMetric(peak.stop(), PeakHeapMetric)
+	}
+}
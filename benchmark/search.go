@@ -0,0 +1,127 @@
+package benchmark
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// searchSortColumns are the columns a search can be sorted by, besides the default id.
+var searchSortColumns = []string{"title", "publicized_at", "quantity"}
+
+// searchFilter holds the optional filters of a search, where nil means unset. Books are sorted by
+// SortBy, then by id.
+type searchFilter struct {
+	AuthorPrefix    *string
+	Genre           *string
+	PublishedAfter  *time.Time
+	PublishedBefore *time.Time
+	MinQuantity     *int
+	MaxQuantity     *int
+	SortBy          string
+	Descending      bool
+}
+
+// newSearchFilter returns a filter applying each of the author prefix, genre, date range,
+// quantity bounds and sorting at random.
+func newSearchFilter(rng *rand.Rand) searchFilter {
+	f := searchFilter{SortBy: "id"}
+	if rng.Intn(2) == 0 {
+		prefix := "Jon"
+		f.AuthorPrefix = &prefix
+	}
+	if rng.Intn(2) == 0 {
+		genre := model.Genres[rng.Intn(len(model.Genres))]
+		f.Genre = &genre
+	}
+	if rng.Intn(2) == 0 {
+		after := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+		before := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+		f.PublishedAfter, f.PublishedBefore = &after, &before
+	}
+	if rng.Intn(2) == 0 {
+		minQuantity, maxQuantity := 10, 30
+		f.MinQuantity, f.MaxQuantity = &minQuantity, &maxQuantity
+	}
+	if rng.Intn(2) == 0 {
+		f.SortBy = searchSortColumns[rng.Intn(len(searchSortColumns))]
+		f.Descending = rng.Intn(2) == 0
+	}
+	return f
+}
+
+// searchBenchmark seeds utils.BulkInsertNumber books spread across model.Genres, then measures
+// search fetching a page of utils.PageSize books with a random filter per iteration.
+func searchBenchmark(b *testing.B, search func(f searchFilter) error) {
+	if err := truncateBooks(); err != nil {
+		b.Error(err)
+		return
+	}
+	if err := seedBooks(model.NewBooksAcrossGenres[int64](utils.BulkInsertNumber)); err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+		var f searchFilter
+		return step{
+			prepare: func(int) {
+				f = newSearchFilter(rng)
+			},
+			exec: func(int) error {
+				return search(f)
+			},
+		}
+	})
+}
+
+// searchQuery builds the query of the filter by hand, along with its arguments.
+func searchQuery(f searchFilter) (string, []interface{}) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+	where := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if f.AuthorPrefix != nil {
+		where("author LIKE $%d", *f.AuthorPrefix+"%")
+	}
+	if f.Genre != nil {
+		where("genre = $%d", *f.Genre)
+	}
+	if f.PublishedAfter != nil {
+		where("publicized_at >= $%d", *f.PublishedAfter)
+	}
+	if f.PublishedBefore != nil {
+		where("publicized_at < $%d", *f.PublishedBefore)
+	}
+	if f.MinQuantity != nil {
+		where("quantity >= $%d", *f.MinQuantity)
+	}
+	if f.MaxQuantity != nil {
+		where("quantity <= $%d", *f.MaxQuantity)
+	}
+
+	var query strings.Builder
+	query.WriteString("SELECT * FROM books")
+	if len(conditions) > 0 {
+		query.WriteString(" WHERE ")
+		query.WriteString(strings.Join(conditions, " AND "))
+	}
+	query.WriteString(" ORDER BY ")
+	query.WriteString(f.SortBy)
+	if f.Descending {
+		query.WriteString(" DESC")
+	}
+	args = append(args, utils.PageSize)
+	_, _ = fmt.Fprintf(&query, ", id LIMIT $%d", len(args))
+	return query.String(), args
+}
@@ -0,0 +1,257 @@
+package benchmark
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+	queries "github.com/andreiac-silva/golang-orm-benchmarks/sql"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var pricePolicyColumns = []string{"book_id", "price", "start_date", "end_date"}
+
+// seedBooks inserts the books, letting the database generate their keys. Seeding isn't measured, so
+// all adapters share these pgx implementations.
+func seedBooks(books []*model.Book[int64]) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, utils.PostgresDSN)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	rows := make([][]interface{}, len(books))
+	for i, book := range books {
+		rows[i] = []interface{}{book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt}
+	}
+	_, err = conn.CopyFrom(ctx, pgx.Identifier{"books"}, columns, pgx.CopyFromRows(rows))
+	return err
+}
+
+// seedKeyedBooks inserts n books and returns their keys, in increasing order.
+func seedKeyedBooks[K model.Key](n int) ([]K, error) {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, utils.PostgresDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+	return copyBooks[K](ctx, conn, n)
+}
+
+// seedPricedBooks inserts n books along with their price policies, see model.NewPricePolicies,
+// and returns the keys of the new books.
+func seedPricedBooks[K model.Key](n int, now time.Time) ([]K, error) {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, utils.PostgresDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	ids, err := copyBooks[K](ctx, conn, n)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([][]interface{}, 0, len(ids)*3)
+	for _, id := range ids {
+		for _, policy := range model.NewPricePolicies(id, now) {
+			policies = append(policies, []interface{}{policy.BookID, policy.Price, policy.StartDate, policy.EndDate})
+		}
+	}
+	_, err = conn.CopyFrom(ctx, pgx.Identifier{"price_policies"}, pricePolicyColumns, pgx.CopyFromRows(policies))
+	return ids, err
+}
+
+// copyBooks inserts n books through conn and returns their keys, in increasing order.
+func copyBooks[K model.Key](ctx context.Context, conn *pgx.Conn, n int) ([]K, error) {
+	books := make([][]interface{}, n)
+	for i, book := range model.NewBooks[K](n) {
+		books[i] = []interface{}{book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt}
+	}
+	return copyRows[K](ctx, conn, "books", columns, books)
+}
+
+// copyRows copies the rows into the table, whose id column is the primary key, and returns the
+// keys of the new rows, in increasing order.
+func copyRows[K model.Key](ctx context.Context, conn *pgx.Conn, table string, columns []string, rows [][]interface{}) ([]K, error) {
+	// UUIDs generated by the database only increase from a millisecond to the next, so the new rows
+	// couldn't be told apart from the ones before. uuid.NewV7 keeps increasing within a millisecond.
+	if keys, ok := any(make([]K, len(rows))).([]uuid.UUID); ok {
+		for i := range rows {
+			keys[i] = uuid.Must(uuid.NewV7())
+			rows[i] = append([]interface{}{keys[i]}, rows[i]...)
+		}
+		_, err := conn.CopyFrom(ctx, pgx.Identifier{table}, append([]string{"id"}, columns...), pgx.CopyFromRows(rows))
+		return any(keys).([]K), err
+	}
+
+	identifier := pgx.Identifier{table}.Sanitize()
+	var lastID int64
+	if err := conn.QueryRow(ctx, "SELECT COALESCE(MAX(id), 0) FROM "+identifier).Scan(&lastID); err != nil {
+		return nil, err
+	}
+	if _, err := conn.CopyFrom(ctx, pgx.Identifier{table}, columns, pgx.CopyFromRows(rows)); err != nil {
+		return nil, err
+	}
+
+	ids, err := conn.Query(ctx, "SELECT id FROM "+identifier+" WHERE id > $1 ORDER BY id", lastID)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(ids, pgx.RowTo[K])
+}
+
+// seedUniqueBooks adds a unique index on isbn, see queries.UniqueISBNSQL, and inserts n books
+// with distinct ISBNs, returning them.
+func seedUniqueBooks[K model.Key](n int) ([]*model.Book[K], error) {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, utils.PostgresDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	if _, err = conn.Exec(ctx, queries.UniqueISBNSQL); err != nil {
+		return nil, err
+	}
+
+	books := make([]*model.Book[K], n)
+	rows := make([][]interface{}, n)
+	for i := range books {
+		books[i] = model.NewUniqueBook[K]()
+		rows[i] = []interface{}{books[i].ISBN, books[i].Title, books[i].Author, books[i].Genre, books[i].Quantity, books[i].PublicizedAt}
+	}
+	_, err = conn.CopyFrom(ctx, pgx.Identifier{"books"}, columns, pgx.CopyFromRows(rows))
+	return books, err
+}
+
+// seedGenres inserts n books of each genre.
+func seedGenres(genres []string, n int) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, utils.PostgresDSN)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	i := 0
+	rows := pgx.CopyFromFunc(func() ([]interface{}, error) {
+		if i == len(genres)*n {
+			return nil, nil
+		}
+		book := model.NewBookOfGenre[int64](genres[i/n])
+		i++
+		return []interface{}{book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt}, nil
+	})
+	_, err = conn.CopyFrom(ctx, pgx.Identifier{"books"}, columns, rows)
+	return err
+}
+
+// truncateBooks deletes every book, along with its price policies, for benchmarks whose queries
+// read the whole table, as testing.Benchmark runs them several times on the same database.
+func truncateBooks() error {
+	return truncate("books")
+}
+
+// truncateNullableBooks deletes every book of the nullable variant, like truncateBooks.
+func truncateNullableBooks() error {
+	return truncate("nullable_books")
+}
+
+// truncateJSONBooks deletes every book of the JSONB variant, like truncateBooks.
+func truncateJSONBooks() error {
+	return truncate("json_books")
+}
+
+// truncateTaggedBooks deletes every book of the TEXT[] variant, like truncateBooks.
+func truncateTaggedBooks() error {
+	return truncate("tagged_books")
+}
+
+func truncate(table string) error {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, utils.PostgresDSN)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	_, err = conn.Exec(ctx, "TRUNCATE "+pgx.Identifier{table}.Sanitize()+" RESTART IDENTITY CASCADE")
+	return err
+}
+
+// seedNullableBooks inserts n books into the nullable variant, see model.NewNullableBooks, and
+// returns their keys, in increasing order.
+func seedNullableBooks[K model.Key](n int) ([]K, error) {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, utils.PostgresDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	books := make([][]interface{}, n)
+	for i, book := range model.NewNullableBooks[K](n) {
+		books[i] = nullableBookValues(book)
+	}
+	return copyRows[K](ctx, conn, "nullable_books", nullableColumns, books)
+}
+
+// seedJSONBooks inserts n books into the JSONB variant, with metadata holding the given number
+// of reviews, and returns their keys, in increasing order.
+func seedJSONBooks[K model.Key](n, reviews int) ([]K, error) {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, utils.PostgresDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	book := model.NewJSONBook[K](reviews)
+	books := make([][]interface{}, n)
+	for i := range books {
+		books[i] = []interface{}{book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt, book.Metadata}
+	}
+	return copyRows[K](ctx, conn, "json_books", jsonColumns, books)
+}
+
+// seedTaggedBooks inserts n books into the TEXT[] variant, see model.NewTaggedBooks, and returns
+// their keys, in increasing order.
+func seedTaggedBooks[K model.Key](n int) ([]K, error) {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, utils.PostgresDSN)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = conn.Close(ctx)
+	}()
+
+	books := make([][]interface{}, n)
+	for i, book := range model.NewTaggedBooks[K](n) {
+		books[i] = []interface{}{book.ISBN, book.Title, book.Author, book.Genre, book.Quantity, book.PublicizedAt, []string(book.Tags)}
+	}
+	return copyRows[K](ctx, conn, "tagged_books", taggedColumns, books)
+}
@@ -3,27 +3,30 @@ package benchmark
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/sqlc/repository"
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
 	"github.com/andreiac-silva/golang-orm-benchmarks/model"
 
-	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type SqlcBenchmark struct {
 	repository *repository.Queries
-	db         *pgx.Conn
+	db         utils.PgxConn
 	ctx        context.Context
 }
 
 func NewSqlcBenchmark() Benchmark {
+	if utils.PrimaryKey == utils.UUIDKey {
+		return &SqlcUUIDBenchmark{SqlcBenchmark: SqlcBenchmark{ctx: context.Background()}}
+	}
 	return &SqlcBenchmark{ctx: context.Background()}
 }
 
 func (s *SqlcBenchmark) Init() error {
-	conn, err := pgx.Connect(context.Background(), utils.PostgresDSN)
+	conn, err := utils.NewPgxConn(s.ctx)
 	if err != nil {
 		return err
 	}
@@ -33,206 +36,557 @@ func (s *SqlcBenchmark) Init() error {
 }
 
 func (s *SqlcBenchmark) Close() error {
-	return s.db.Close(s.ctx)
+	return utils.ClosePgxConn(s.ctx, s.db)
 }
 
 func (s *SqlcBenchmark) Insert(b *testing.B) {
-	book := model.NewBook()
-
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		book.ID = 0
-		b.StartTimer()
-
-		err := s.repository.Create(s.ctx, repository.CreateParams{
-			Isbn:         book.ISBN,
-			Title:        book.Title,
-			Author:       book.Author,
-			Genre:        book.Genre,
-			Quantity:     int32(book.Quantity),
-			PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
-		})
+	book := model.NewBook[int64]()
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				return s.repository.Create(s.ctx, bookParams(book))
+			},
 		}
-		b.StartTimer()
-	}
+	})
 }
 
 func (s *SqlcBenchmark) InsertBulk(b *testing.B) {
-	books := model.NewBooks(utils.BulkInsertNumber)
-
-	b.ReportAllocs()
-	b.ResetTimer()
+	books := model.NewBooks[int64](utils.BulkInsertNumber)
 
 	batch := make([]repository.CreateManyParams, len(books))
 	for i, newBook := range books {
-		batch[i] = repository.CreateManyParams{
-			Isbn:         newBook.ISBN,
-			Title:        newBook.Title,
-			Author:       newBook.Title,
-			Genre:        newBook.Genre,
-			Quantity:     int32(newBook.Quantity),
-			PublicizedAt: pgtype.Timestamp{Time: newBook.PublicizedAt, Valid: true},
-		}
+		batch[i] = repository.CreateManyParams(bookParams(newBook))
 	}
 
-	for i := 0; i < b.N; i++ {
-		_, err := s.repository.CreateMany(s.ctx, batch)
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				_, err := s.repository.CreateMany(s.ctx, batch)
+				return err
+			},
+		}
+	})
+}
 
-		b.StopTimer()
+func (s *SqlcBenchmark) Update(b *testing.B) {
+	run(b, func() step {
+		book := model.NewBook[int64]()
+		ids, err := createBooks(1, s.createReturningID)
 		if err != nil {
 			b.Error(err)
+			return step{exec: func(int) error { return err }}
 		}
-		b.StartTimer()
-	}
+		return step{
+			exec: func(int) error {
+				return s.repository.Update(s.ctx, repository.UpdateParams{
+					ID:           int32(ids[0]),
+					Isbn:         book.ISBN,
+					Title:        book.Title,
+					Author:       book.Author,
+					Genre:        book.Genre,
+					Quantity:     int32(book.Quantity),
+					PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+				})
+			},
+		}
+	})
 }
 
-func (s *SqlcBenchmark) Update(b *testing.B) {
-	book := model.NewBook()
+func (s *SqlcBenchmark) Delete(b *testing.B) {
+	bookIDs, err := createBooks(b.N, s.createReturningID)
+	if err != nil {
+		b.Error(err)
+		return
+	}
 
-	id, err := s.repository.CreateReturningID(s.ctx, repository.CreateReturningIDParams{
-		Isbn:         book.ISBN,
-		Title:        book.Title,
-		Author:       book.Author,
-		Genre:        book.Genre,
-		Quantity:     int32(book.Quantity),
-		PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				return s.repository.Delete(s.ctx, int32(bookIDs[i]))
+			},
+		}
 	})
+}
+
+func (s *SqlcBenchmark) FindByID(b *testing.B) {
+	savedIDs, err := createBooks(b.N, s.createReturningID)
 	if err != nil {
 		b.Error(err)
+		return
 	}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := s.repository.Get(s.ctx, int32(savedIDs[i]))
+				return err
+			},
+		}
+	})
+}
 
-	for i := 0; i < b.N; i++ {
-		err = s.repository.Update(s.ctx, repository.UpdateParams{
-			ID:           id,
-			Isbn:         book.ISBN,
-			Title:        book.Title,
-			Author:       book.Author,
-			Genre:        book.Genre,
-			Quantity:     int32(book.Quantity),
-			PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
-		})
+func (s *SqlcBenchmark) FindPage(b *testing.B) {
+	bookIDs, err := createBooks(b.N, s.createReturningID)
+	if err != nil {
+		b.Error(err)
+		return
+	}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := s.repository.ListPaginating(s.ctx, repository.ListPaginatingParams{
+					ID:    int32(cursorAt(bookIDs, i)),
+					Limit: int32(utils.PageSize),
+				})
+				return err
+			},
 		}
-		b.StartTimer()
+	})
+}
+
+// createReturningID inserts a book with the params and returns the key the database generated.
+func (s *SqlcBenchmark) createReturningID(params repository.CreateReturningIDParams) (int64, error) {
+	id, err := s.repository.CreateReturningID(s.ctx, params)
+	return int64(id), err
+}
+
+func (s *SqlcBenchmark) FindWithActivePolicy(b *testing.B) {
+	now := time.Now().UTC()
+	keys, err := seedPricedBooks[int64](b.N, now)
+	if err != nil {
+		b.Error(err)
+		return
 	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := s.repository.ListWithActivePolicy(s.ctx, repository.ListWithActivePolicyParams{
+					ActiveAt: pgtype.Timestamp{Time: now, Valid: true},
+					Cursor:   int32(cursorAt(keys, i)),
+					PageSize: int32(utils.PageSize),
+				})
+				return err
+			},
+		}
+	})
 }
 
-func (s *SqlcBenchmark) Delete(b *testing.B) {
-	n := b.N
-	book := model.NewBook()
-	bookIDs := make([]int32, n)
-	for i := 0; i < n; i++ {
-		id, err := s.repository.CreateReturningID(s.ctx, repository.CreateReturningIDParams{
-			Isbn:         book.ISBN,
-			Title:        book.Title,
-			Author:       book.Author,
-			Genre:        book.Genre,
-			Quantity:     int32(book.Quantity),
-			PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+func (s *SqlcBenchmark) FindPageWithPolicies(strategy LoadStrategy) func(b *testing.B) {
+	page := func(cursor int64) ([]repository.Book, error) {
+		return s.repository.ListPaginating(s.ctx, repository.ListPaginatingParams{
+			ID:    int32(cursor),
+			Limit: int32(utils.PageSize),
 		})
-		if err != nil {
-			b.Error(err)
+	}
+
+	switch strategy {
+	case NPlusOne:
+		return eagerBenchmark(func(cursor int64) error {
+			books, err := page(cursor)
+			if err != nil {
+				return err
+			}
+			for _, book := range books {
+				_, err = s.repository.ListPoliciesByBook(s.ctx, pgtype.Int4{Int32: book.ID, Valid: true})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	case Preload:
+		return eagerBenchmark(func(cursor int64) error {
+			books, err := page(cursor)
+			if err != nil {
+				return err
+			}
+			ids := make([]int32, len(books))
+			for j, book := range books {
+				ids[j] = book.ID
+			}
+			policies, err := s.repository.ListPoliciesByBooks(s.ctx, ids)
+			if err != nil {
+				return err
+			}
+			// Group the policies by book, as the other strategies do.
+			byBook := make(map[int32][]repository.PricePolicy, len(books))
+			for _, policy := range policies {
+				byBook[policy.BookID.Int32] = append(byBook[policy.BookID.Int32], policy)
+			}
+			return nil
+		})
+	case Join:
+		return eagerBenchmark(func(cursor int64) error {
+			rows, err := s.repository.ListPageWithPolicies(s.ctx, repository.ListPageWithPoliciesParams{
+				Cursor:   int32(cursor),
+				PageSize: int32(utils.PageSize),
+			})
+			if err != nil {
+				return err
+			}
+			byBook := make(map[int32][]repository.PricePolicy, utils.PageSize)
+			for _, row := range rows {
+				byBook[row.Book.ID] = append(byBook[row.Book.ID], row.PricePolicy)
+			}
+			return nil
+		})
+	}
+	return nil
+}
+
+func (s *SqlcBenchmark) Transaction(b *testing.B) {
+	s.transaction(b, false)
+}
+
+func (s *SqlcBenchmark) TransactionRollback(b *testing.B) {
+	s.transaction(b, true)
+}
+
+func (s *SqlcBenchmark) transaction(b *testing.B, rollback bool) {
+	now := time.Now().UTC()
+	book := model.NewBook[int64]()
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				tx, err := s.db.Begin(s.ctx)
+				if err != nil {
+					return err
+				}
+				// Rolling back a committed transaction is a no-op.
+				defer func() {
+					_ = tx.Rollback(s.ctx)
+				}()
+				queries := s.repository.WithTx(tx)
+
+				id, err := queries.CreateReturningID(s.ctx, repository.CreateReturningIDParams(bookParams(book)))
+				if err != nil {
+					return err
+				}
+
+				params := repository.CreatePricePoliciesParams{BookID: id}
+				params.Prices, params.StartDates, params.EndDates = pricePolicyParams(model.NewPricePolicies(int64(id), now))
+				if err = queries.CreatePricePolicies(s.ctx, params); err != nil {
+					return err
+				}
+
+				if rollback {
+					return tx.Rollback(s.ctx)
+				}
+				return tx.Commit(s.ctx)
+			},
 		}
-		bookIDs[i] = id
+	})
+}
+
+func (s *SqlcBenchmark) Upsert(b *testing.B) {
+	upsertBenchmark(b, func(books []*model.Book[int64]) error {
+		return s.repository.UpsertMany(s.ctx, upsertParams(books))
+	})
+}
+
+func (s *SqlcBenchmark) UpdateBulk(affected int) func(b *testing.B) {
+	return updateBulkBenchmark(affected, func(genre string, quantity int) error {
+		return s.repository.UpdateQuantityByGenre(s.ctx, repository.UpdateQuantityByGenreParams{
+			Quantity: int32(quantity),
+			Genre:    genre,
+		})
+	})
+}
+
+func (s *SqlcBenchmark) DeleteBulk(affected int) func(b *testing.B) {
+	return deleteBulkBenchmark(affected, func(genre string) error {
+		return s.repository.DeleteByGenre(s.ctx, genre)
+	})
+}
+
+// FindByIDs only supports AnyArray, as sqlc.slice is limited to MySQL and SQLite.
+func (s *SqlcBenchmark) FindByIDs(list IDList) func(b *testing.B) {
+	if list != AnyArray {
+		return nil
 	}
+	return manyIDsBenchmark(func(ids []int64) error {
+		_, err := s.repository.ListByIDs(s.ctx, ids32(ids))
+		return err
+	})
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+func (s *SqlcBenchmark) Aggregate(b *testing.B) {
+	aggregateBenchmark(b, func() error {
+		_, err := s.repository.ListGenreStats(s.ctx)
+		return err
+	})
+}
 
-	for i := 0; i < b.N; i++ {
-		err := s.repository.Delete(s.ctx, bookIDs[i])
+func (s *SqlcBenchmark) Search(b *testing.B) {
+	searchBenchmark(b, func(f searchFilter) error {
+		_, err := s.repository.Search(s.ctx, searchParams(f))
+		return err
+	})
+}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
+func (s *SqlcBenchmark) FindPageAt(pagination Pagination, depth int) func(b *testing.B) {
+	return paginationBenchmark(depth, func(depth int, cursor int64) error {
+		var err error
+		if pagination == Offset {
+			_, err = s.repository.ListOffset(s.ctx, repository.ListOffsetParams{
+				Offset: int32(depth),
+				Limit:  int32(utils.PageSize),
+			})
+		} else {
+			_, err = s.repository.ListPaginating(s.ctx, repository.ListPaginatingParams{
+				ID:    int32(cursor),
+				Limit: int32(utils.PageSize),
+			})
 		}
-		b.StartTimer()
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FindTitles(b *testing.B) {
+	projectionBenchmark(b, func(cursor int64) error {
+		_, err := s.repository.ListTitles(s.ctx, repository.ListTitlesParams{
+			ID:    int32(cursor),
+			Limit: int32(utils.PageSize),
+		})
+		return err
+	})
+}
+
+// ScanLarge has no streaming mode, as sqlc generates :many queries returning slices.
+func (s *SqlcBenchmark) ScanLarge(mode ScanMode) func(b *testing.B) {
+	if mode != Buffered {
+		return nil
 	}
+	return scanLargeBenchmark(func() error {
+		_, err := s.repository.ListAll(s.ctx)
+		return err
+	})
 }
 
-func (s *SqlcBenchmark) FindByID(b *testing.B) {
-	n := b.N
-	book := model.NewBook()
-	savedIDs := make([]int32, n)
-	for i := 0; i < n; i++ {
-		id, err := s.repository.CreateReturningID(s.ctx, repository.CreateReturningIDParams{
-			Isbn:         book.ISBN,
-			Title:        book.Title,
-			Author:       book.Author,
-			Genre:        book.Genre,
-			Quantity:     int32(book.Quantity),
-			PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+func (s *SqlcBenchmark) InsertNullable(b *testing.B) {
+	insertNullableBenchmark(b, func(book *model.NullableBook[int64]) error {
+		return s.repository.CreateNullable(s.ctx, nullableParams(book))
+	})
+}
+
+func (s *SqlcBenchmark) FindNullable(b *testing.B) {
+	findNullableBenchmark(b, func(cursor int64) error {
+		_, err := s.repository.ListNullablePaginating(s.ctx, repository.ListNullablePaginatingParams{
+			ID:    int32(cursor),
+			Limit: int32(utils.PageSize),
+		})
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) UpdateNullable(b *testing.B) {
+	updateNullableBenchmark(b, func(book *model.NullableBook[int64]) error {
+		return s.repository.UpdateNullable(s.ctx, repository.UpdateNullableParams{
+			ID:             int32(book.ID),
+			Isbn:           book.ISBN,
+			Title:          book.Title,
+			Subtitle:       pgText(book.Subtitle),
+			Description:    pgtype.Text{String: book.Description.String, Valid: book.Description.Valid},
+			Author:         book.Author,
+			Genre:          book.Genre,
+			Quantity:       int32(book.Quantity),
+			PublicizedAt:   pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+			DiscontinuedAt: pgTimestamp(book.DiscontinuedAt),
 		})
+	})
+}
+
+// pgText converts s into the pgtype sqlc generates for nullable text, NULL when s is nil.
+func pgText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
+
+// pgTimestamp converts t into the pgtype sqlc generates for nullable timestamps, NULL when t is nil.
+func pgTimestamp(t *time.Time) pgtype.Timestamp {
+	if t == nil {
+		return pgtype.Timestamp{}
+	}
+	return pgtype.Timestamp{Time: *t, Valid: true}
+}
+
+// createBooks inserts n books one at a time through create and returns the keys the database
+// generated. Both key variants seed the operations on single rows with it.
+func createBooks[K model.Key](n int, create func(repository.CreateReturningIDParams) (K, error)) ([]K, error) {
+	params := repository.CreateReturningIDParams(bookParams(model.NewBook[K]()))
+	ids := make([]K, n)
+	for i := range ids {
+		id, err := create(params)
 		if err != nil {
-			b.Error(err)
+			return nil, err
 		}
-		savedIDs[i] = id
+		ids[i] = id
 	}
+	return ids, nil
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+// bookParams builds the parameters of the queries inserting a book. Those of the other inserts, and
+// those of uuidrepository, which never carry a key, convert from them.
+func bookParams[K model.Key](book *model.Book[K]) repository.CreateParams {
+	return repository.CreateParams{
+		Isbn:         book.ISBN,
+		Title:        book.Title,
+		Author:       book.Author,
+		Genre:        book.Genre,
+		Quantity:     int32(book.Quantity),
+		PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+	}
+}
 
-	var bookID int32
-	for i := 0; i < b.N; i++ {
-		b.StopTimer()
-		bookID = savedIDs[i]
-		b.StartTimer()
+// pricePolicyParams builds the columns of the policies, which CreatePricePolicies unnests.
+func pricePolicyParams[K model.Key](policies []*model.PricePolicy[K]) ([]float64, []pgtype.Timestamp, []pgtype.Timestamp) {
+	prices := make([]float64, len(policies))
+	startDates := make([]pgtype.Timestamp, len(policies))
+	endDates := make([]pgtype.Timestamp, len(policies))
+	for i, policy := range policies {
+		prices[i] = policy.Price
+		startDates[i] = pgtype.Timestamp{Time: policy.StartDate, Valid: true}
+		endDates[i] = pgtype.Timestamp{Time: policy.EndDate, Valid: true}
+	}
+	return prices, startDates, endDates
+}
 
-		_, err := s.repository.Get(s.ctx, bookID)
+// upsertParams builds the columns of the books, which UpsertMany unnests.
+func upsertParams[K model.Key](books []*model.Book[K]) repository.UpsertManyParams {
+	params := repository.UpsertManyParams{
+		Isbns:         make([]string, len(books)),
+		Titles:        make([]string, len(books)),
+		Authors:       make([]string, len(books)),
+		Genres:        make([]string, len(books)),
+		Quantities:    make([]int32, len(books)),
+		PublicizedAts: make([]pgtype.Timestamp, len(books)),
+	}
+	for i, book := range books {
+		params.Isbns[i] = book.ISBN
+		params.Titles[i] = book.Title
+		params.Authors[i] = book.Author
+		params.Genres[i] = book.Genre
+		params.Quantities[i] = int32(book.Quantity)
+		params.PublicizedAts[i] = pgtype.Timestamp{Time: book.PublicizedAt, Valid: true}
+	}
+	return params
+}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
-		}
-		b.StartTimer()
+// searchParams maps the filter to the parameters of Search, leaving the filters it doesn't set NULL.
+func searchParams(f searchFilter) repository.SearchParams {
+	params := repository.SearchParams{
+		SortBy:     f.SortBy,
+		Descending: f.Descending,
+		PageSize:   int32(utils.PageSize),
+	}
+	if f.AuthorPrefix != nil {
+		params.AuthorPrefix = pgtype.Text{String: *f.AuthorPrefix, Valid: true}
+	}
+	if f.Genre != nil {
+		params.Genre = pgtype.Text{String: *f.Genre, Valid: true}
+	}
+	if f.PublishedAfter != nil {
+		params.PublishedAfter = pgtype.Timestamp{Time: *f.PublishedAfter, Valid: true}
+	}
+	if f.PublishedBefore != nil {
+		params.PublishedBefore = pgtype.Timestamp{Time: *f.PublishedBefore, Valid: true}
 	}
+	if f.MinQuantity != nil {
+		params.MinQuantity = pgtype.Int4{Int32: int32(*f.MinQuantity), Valid: true}
+	}
+	if f.MaxQuantity != nil {
+		params.MaxQuantity = pgtype.Int4{Int32: int32(*f.MaxQuantity), Valid: true}
+	}
+	return params
 }
 
-func (s *SqlcBenchmark) FindPage(b *testing.B) {
-	n := b.N
-	book := model.NewBook()
-	bookIDs := make([]int32, n)
-	for i := 0; i < n; i++ {
-		id, err := s.repository.CreateReturningID(s.ctx, repository.CreateReturningIDParams{
-			Isbn:         book.ISBN,
-			Title:        book.Title,
-			Author:       book.Author,
-			Genre:        book.Genre,
-			Quantity:     int32(book.Quantity),
-			PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
-		})
-		if err != nil {
-			b.Error(err)
-		}
-		bookIDs[i] = id
+// nullableParams builds the parameters of CreateNullable, NULL where the book has no value.
+func nullableParams[K model.Key](book *model.NullableBook[K]) repository.CreateNullableParams {
+	return repository.CreateNullableParams{
+		Isbn:           book.ISBN,
+		Title:          book.Title,
+		Subtitle:       pgText(book.Subtitle),
+		Description:    pgtype.Text{String: book.Description.String, Valid: book.Description.Valid},
+		Author:         book.Author,
+		Genre:          book.Genre,
+		Quantity:       int32(book.Quantity),
+		PublicizedAt:   pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+		DiscontinuedAt: pgTimestamp(book.DiscontinuedAt),
+	}
+}
+
+// jsonParams builds the parameters of CreateJSON.
+func jsonParams[K model.Key](book *model.JSONBook[K]) repository.CreateJSONParams {
+	return repository.CreateJSONParams{
+		Isbn:         book.ISBN,
+		Title:        book.Title,
+		Author:       book.Author,
+		Genre:        book.Genre,
+		Quantity:     int32(book.Quantity),
+		PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+		Metadata:     book.Metadata,
+	}
+}
+
+// taggedParams builds the parameters of CreateTagged.
+func taggedParams[K model.Key](book *model.TaggedBook[K]) repository.CreateTaggedParams {
+	return repository.CreateTaggedParams{
+		Isbn:         book.ISBN,
+		Title:        book.Title,
+		Author:       book.Author,
+		Genre:        book.Genre,
+		Quantity:     int32(book.Quantity),
+		PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+		Tags:         book.Tags,
 	}
+}
 
-	b.ReportAllocs()
-	b.ResetTimer()
+// InsertJSON relies on an override mapping the metadata column to model.BookMetadata, which pgx
+// encodes with its JSON codec.
+func (s *SqlcBenchmark) InsertJSON(size DocumentSize) func(b *testing.B) {
+	return insertJSONBenchmark(size, func(book *model.JSONBook[int64]) error {
+		return s.repository.CreateJSON(s.ctx, jsonParams(book))
+	})
+}
 
-	for i := 0; i < b.N; i++ {
-		_, err := s.repository.ListPaginating(s.ctx, repository.ListPaginatingParams{
-			ID:    int32(i),
-			Limit: utils.PageSize,
+func (s *SqlcBenchmark) FindJSON(size DocumentSize) func(b *testing.B) {
+	return findJSONBenchmark(size, func(cursor int64) error {
+		_, err := s.repository.ListJSONPaginating(s.ctx, repository.ListJSONPaginatingParams{
+			ID:    int32(cursor),
+			Limit: int32(utils.PageSize),
 		})
+		return err
+	})
+}
 
-		b.StopTimer()
-		if err != nil {
-			b.Error(err)
-		}
-		b.StartTimer()
-	}
+func (s *SqlcBenchmark) InsertTags(b *testing.B) {
+	insertTagsBenchmark(b, func(book *model.TaggedBook[int64]) error {
+		return s.repository.CreateTagged(s.ctx, taggedParams(book))
+	})
+}
+
+func (s *SqlcBenchmark) FindTags(b *testing.B) {
+	findTagsBenchmark(b, func(cursor int64) error {
+		_, err := s.repository.ListTaggedPaginating(s.ctx, repository.ListTaggedPaginatingParams{
+			ID:    int32(cursor),
+			Limit: int32(utils.PageSize),
+		})
+		return err
+	})
+}
+
+func (s *SqlcBenchmark) FilterTags(b *testing.B) {
+	filterTagsBenchmark[int64](b, func(tags []string) error {
+		_, err := s.repository.ListByTags(s.ctx, repository.ListByTagsParams{
+			Tags:     tags,
+			PageSize: int32(utils.PageSize),
+		})
+		return err
+	})
 }
@@ -0,0 +1,141 @@
+-- name: Create :exec
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: CreateMany :copyfrom
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: CreateReturningID :one
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: Update :exec
+UPDATE books
+SET isbn = $1,
+    title = $2,
+    author = $3,
+    genre = $4,
+    quantity = $5,
+    publicized_at = $6
+WHERE id = $7;
+
+-- name: Delete :exec
+DELETE FROM books WHERE id = $1;
+
+-- name: DeleteByGenre :exec
+DELETE FROM books WHERE genre = $1;
+
+-- name: UpdateQuantityByGenre :exec
+UPDATE books SET quantity = $1 WHERE genre = $2;
+
+-- name: Get :one
+SELECT * FROM books WHERE id = $1 ;
+
+-- name: ListAll :many
+SELECT * FROM books;
+
+-- name: ListByIDs :many
+SELECT * FROM books WHERE id = ANY(@ids::uuid[]);
+
+-- name: ListPaginating :many
+SELECT * FROM books WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: ListOffset :many
+SELECT * FROM books ORDER BY id OFFSET $1 LIMIT $2;
+
+-- name: ListTitles :many
+SELECT id, title FROM books WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: ListWithActivePolicy :many
+SELECT sqlc.embed(b), sqlc.embed(pp)
+FROM books b
+JOIN price_policies pp ON pp.book_id = b.id AND pp.start_date <= @active_at AND pp.end_date > @active_at
+WHERE b.id > @cursor
+ORDER BY b.id
+LIMIT @page_size;
+
+-- name: ListPoliciesByBook :many
+SELECT * FROM price_policies WHERE book_id = $1 ORDER BY id;
+
+-- name: ListPoliciesByBooks :many
+SELECT * FROM price_policies WHERE book_id = ANY(@book_ids::uuid[]) ORDER BY book_id, id;
+
+-- name: ListGenreStats :many
+SELECT genre, COUNT(*) AS books, SUM(quantity) AS total_quantity, AVG(quantity) AS average_quantity
+FROM books
+GROUP BY genre;
+
+-- name: ListPageWithPolicies :many
+SELECT sqlc.embed(b), sqlc.embed(pp)
+FROM books b
+JOIN price_policies pp ON pp.book_id = b.id
+WHERE b.id IN (SELECT id FROM books WHERE id > @cursor ORDER BY id LIMIT @page_size)
+ORDER BY b.id, pp.id;
+
+-- name: CreatePricePolicies :exec
+INSERT INTO price_policies (book_id, price, start_date, end_date)
+SELECT @book_id::uuid, unnest(@prices::float8[]), unnest(@start_dates::timestamp[]), unnest(@end_dates::timestamp[]);
+
+-- name: UpsertMany :exec
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+SELECT unnest(@isbns::varchar[]), unnest(@titles::varchar[]), unnest(@authors::varchar[]), unnest(@genres::varchar[]),
+       unnest(@quantities::int[]), unnest(@publicized_ats::timestamp[])
+ON CONFLICT (isbn) DO UPDATE SET quantity = EXCLUDED.quantity;
+
+-- name: Search :many
+SELECT * FROM books
+WHERE (sqlc.narg(author_prefix)::text IS NULL OR author LIKE sqlc.narg(author_prefix) || '%')
+  AND (sqlc.narg(genre)::text IS NULL OR genre = sqlc.narg(genre))
+  AND (sqlc.narg(published_after)::timestamp IS NULL OR publicized_at >= sqlc.narg(published_after))
+  AND (sqlc.narg(published_before)::timestamp IS NULL OR publicized_at < sqlc.narg(published_before))
+  AND (sqlc.narg(min_quantity)::int IS NULL OR quantity >= sqlc.narg(min_quantity))
+  AND (sqlc.narg(max_quantity)::int IS NULL OR quantity <= sqlc.narg(max_quantity))
+ORDER BY
+  CASE WHEN @sort_by::text = 'title' AND NOT @descending::bool THEN title END,
+  CASE WHEN @sort_by::text = 'title' AND @descending::bool THEN title END DESC,
+  CASE WHEN @sort_by::text = 'publicized_at' AND NOT @descending::bool THEN publicized_at END,
+  CASE WHEN @sort_by::text = 'publicized_at' AND @descending::bool THEN publicized_at END DESC,
+  CASE WHEN @sort_by::text = 'quantity' AND NOT @descending::bool THEN quantity END,
+  CASE WHEN @sort_by::text = 'quantity' AND @descending::bool THEN quantity END DESC,
+  CASE WHEN @sort_by::text = 'id' AND @descending::bool THEN id END DESC,
+  id
+LIMIT @page_size;
+
+-- name: CreateNullable :exec
+INSERT INTO nullable_books (isbn, title, subtitle, description, author, genre, quantity, publicized_at, discontinued_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: UpdateNullable :exec
+UPDATE nullable_books
+SET isbn = $1,
+    title = $2,
+    subtitle = $3,
+    description = $4,
+    author = $5,
+    genre = $6,
+    quantity = $7,
+    publicized_at = $8,
+    discontinued_at = $9
+WHERE id = $10;
+
+-- name: ListNullablePaginating :many
+SELECT * FROM nullable_books WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: CreateJSON :exec
+INSERT INTO json_books (isbn, title, author, genre, quantity, publicized_at, metadata)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListJSONPaginating :many
+SELECT * FROM json_books WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: CreateTagged :exec
+INSERT INTO tagged_books (isbn, title, author, genre, quantity, publicized_at, tags)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListTaggedPaginating :many
+SELECT * FROM tagged_books WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: ListByTags :many
+SELECT * FROM tagged_books WHERE tags @> @tags::text[] ORDER BY id LIMIT @page_size;
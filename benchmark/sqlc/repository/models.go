@@ -5,6 +5,7 @@
 package repository
 
 import (
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -17,3 +18,46 @@ type Book struct {
 	Quantity     int32
 	PublicizedAt pgtype.Timestamp
 }
+
+type JsonBook struct {
+	ID           int32
+	Isbn         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int32
+	PublicizedAt pgtype.Timestamp
+	Metadata     model.BookMetadata
+}
+
+type NullableBook struct {
+	ID             int32
+	Isbn           string
+	Title          string
+	Subtitle       pgtype.Text
+	Description    pgtype.Text
+	Author         string
+	Genre          string
+	Quantity       int32
+	PublicizedAt   pgtype.Timestamp
+	DiscontinuedAt pgtype.Timestamp
+}
+
+type PricePolicy struct {
+	ID        int32
+	BookID    pgtype.Int4
+	Price     float64
+	StartDate pgtype.Timestamp
+	EndDate   pgtype.Timestamp
+}
+
+type TaggedBook struct {
+	ID           int32
+	Isbn         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int32
+	PublicizedAt pgtype.Timestamp
+	Tags         []string
+}
@@ -8,6 +8,7 @@ package repository
 import (
 	"context"
 
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
@@ -46,6 +47,88 @@ type CreateManyParams struct {
 	PublicizedAt pgtype.Timestamp
 }
 
+const createJSON = `-- name: CreateJSON :exec
+INSERT INTO json_books (isbn, title, author, genre, quantity, publicized_at, metadata)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateJSONParams struct {
+	Isbn         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int32
+	PublicizedAt pgtype.Timestamp
+	Metadata     model.BookMetadata
+}
+
+func (q *Queries) CreateJSON(ctx context.Context, arg CreateJSONParams) error {
+	_, err := q.db.Exec(ctx, createJSON,
+		arg.Isbn,
+		arg.Title,
+		arg.Author,
+		arg.Genre,
+		arg.Quantity,
+		arg.PublicizedAt,
+		arg.Metadata,
+	)
+	return err
+}
+
+const createNullable = `-- name: CreateNullable :exec
+INSERT INTO nullable_books (isbn, title, subtitle, description, author, genre, quantity, publicized_at, discontinued_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type CreateNullableParams struct {
+	Isbn           string
+	Title          string
+	Subtitle       pgtype.Text
+	Description    pgtype.Text
+	Author         string
+	Genre          string
+	Quantity       int32
+	PublicizedAt   pgtype.Timestamp
+	DiscontinuedAt pgtype.Timestamp
+}
+
+func (q *Queries) CreateNullable(ctx context.Context, arg CreateNullableParams) error {
+	_, err := q.db.Exec(ctx, createNullable,
+		arg.Isbn,
+		arg.Title,
+		arg.Subtitle,
+		arg.Description,
+		arg.Author,
+		arg.Genre,
+		arg.Quantity,
+		arg.PublicizedAt,
+		arg.DiscontinuedAt,
+	)
+	return err
+}
+
+const createPricePolicies = `-- name: CreatePricePolicies :exec
+INSERT INTO price_policies (book_id, price, start_date, end_date)
+SELECT $1::int, unnest($2::float8[]), unnest($3::timestamp[]), unnest($4::timestamp[])
+`
+
+type CreatePricePoliciesParams struct {
+	BookID     int32
+	Prices     []float64
+	StartDates []pgtype.Timestamp
+	EndDates   []pgtype.Timestamp
+}
+
+func (q *Queries) CreatePricePolicies(ctx context.Context, arg CreatePricePoliciesParams) error {
+	_, err := q.db.Exec(ctx, createPricePolicies,
+		arg.BookID,
+		arg.Prices,
+		arg.StartDates,
+		arg.EndDates,
+	)
+	return err
+}
+
 const createReturningID = `-- name: CreateReturningID :one
 INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
 VALUES ($1, $2, $3, $4, $5, $6)
@@ -75,6 +158,34 @@ func (q *Queries) CreateReturningID(ctx context.Context, arg CreateReturningIDPa
 	return id, err
 }
 
+const createTagged = `-- name: CreateTagged :exec
+INSERT INTO tagged_books (isbn, title, author, genre, quantity, publicized_at, tags)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateTaggedParams struct {
+	Isbn         string
+	Title        string
+	Author       string
+	Genre        string
+	Quantity     int32
+	PublicizedAt pgtype.Timestamp
+	Tags         []string
+}
+
+func (q *Queries) CreateTagged(ctx context.Context, arg CreateTaggedParams) error {
+	_, err := q.db.Exec(ctx, createTagged,
+		arg.Isbn,
+		arg.Title,
+		arg.Author,
+		arg.Genre,
+		arg.Quantity,
+		arg.PublicizedAt,
+		arg.Tags,
+	)
+	return err
+}
+
 const delete = `-- name: Delete :exec
 DELETE FROM books WHERE id = $1
 `
@@ -84,6 +195,15 @@ func (q *Queries) Delete(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteByGenre = `-- name: DeleteByGenre :exec
+DELETE FROM books WHERE genre = $1
+`
+
+func (q *Queries) DeleteByGenre(ctx context.Context, genre string) error {
+	_, err := q.db.Exec(ctx, deleteByGenre, genre)
+	return err
+}
+
 const get = `-- name: Get :one
 SELECT id, isbn, title, author, genre, quantity, publicized_at FROM books WHERE id = $1
 `
@@ -103,8 +223,314 @@ func (q *Queries) Get(ctx context.Context, id int32) (Book, error) {
 	return i, err
 }
 
+const listAll = `-- name: ListAll :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at FROM books
+`
+
+func (q *Queries) ListAll(ctx context.Context) ([]Book, error) {
+	rows, err := q.db.Query(ctx, listAll)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listByIDs = `-- name: ListByIDs :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at FROM books WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) ListByIDs(ctx context.Context, ids []int32) ([]Book, error) {
+	rows, err := q.db.Query(ctx, listByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listByTags = `-- name: ListByTags :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at, tags FROM tagged_books WHERE tags @> $1::text[] ORDER BY id LIMIT $2
+`
+
+type ListByTagsParams struct {
+	Tags     []string
+	PageSize int32
+}
+
+func (q *Queries) ListByTags(ctx context.Context, arg ListByTagsParams) ([]TaggedBook, error) {
+	rows, err := q.db.Query(ctx, listByTags, arg.Tags, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaggedBook
+	for rows.Next() {
+		var i TaggedBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+			&i.Tags,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGenreStats = `-- name: ListGenreStats :many
+SELECT genre, COUNT(*) AS books, SUM(quantity) AS total_quantity, AVG(quantity) AS average_quantity
+FROM books
+GROUP BY genre
+`
+
+type ListGenreStatsRow struct {
+	Genre           string
+	Books           int64
+	TotalQuantity   int64
+	AverageQuantity pgtype.Numeric
+}
+
+func (q *Queries) ListGenreStats(ctx context.Context) ([]ListGenreStatsRow, error) {
+	rows, err := q.db.Query(ctx, listGenreStats)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListGenreStatsRow
+	for rows.Next() {
+		var i ListGenreStatsRow
+		if err := rows.Scan(
+			&i.Genre,
+			&i.Books,
+			&i.TotalQuantity,
+			&i.AverageQuantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJSONPaginating = `-- name: ListJSONPaginating :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at, metadata FROM json_books WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type ListJSONPaginatingParams struct {
+	ID    int32
+	Limit int32
+}
+
+func (q *Queries) ListJSONPaginating(ctx context.Context, arg ListJSONPaginatingParams) ([]JsonBook, error) {
+	rows, err := q.db.Query(ctx, listJSONPaginating, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []JsonBook
+	for rows.Next() {
+		var i JsonBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+			&i.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNullablePaginating = `-- name: ListNullablePaginating :many
+SELECT id, isbn, title, subtitle, description, author, genre, quantity, publicized_at, discontinued_at FROM nullable_books WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type ListNullablePaginatingParams struct {
+	ID    int32
+	Limit int32
+}
+
+func (q *Queries) ListNullablePaginating(ctx context.Context, arg ListNullablePaginatingParams) ([]NullableBook, error) {
+	rows, err := q.db.Query(ctx, listNullablePaginating, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NullableBook
+	for rows.Next() {
+		var i NullableBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Subtitle,
+			&i.Description,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+			&i.DiscontinuedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOffset = `-- name: ListOffset :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at FROM books ORDER BY id OFFSET $1 LIMIT $2
+`
+
+type ListOffsetParams struct {
+	Offset int32
+	Limit  int32
+}
+
+func (q *Queries) ListOffset(ctx context.Context, arg ListOffsetParams) ([]Book, error) {
+	rows, err := q.db.Query(ctx, listOffset, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPageWithPolicies = `-- name: ListPageWithPolicies :many
+SELECT b.id, b.isbn, b.title, b.author, b.genre, b.quantity, b.publicized_at, pp.id, pp.book_id, pp.price, pp.start_date, pp.end_date
+FROM books b
+JOIN price_policies pp ON pp.book_id = b.id
+WHERE b.id IN (SELECT id FROM books WHERE id > $1 ORDER BY id LIMIT $2)
+ORDER BY b.id, pp.id
+`
+
+type ListPageWithPoliciesParams struct {
+	Cursor   int32
+	PageSize int32
+}
+
+type ListPageWithPoliciesRow struct {
+	Book        Book
+	PricePolicy PricePolicy
+}
+
+func (q *Queries) ListPageWithPolicies(ctx context.Context, arg ListPageWithPoliciesParams) ([]ListPageWithPoliciesRow, error) {
+	rows, err := q.db.Query(ctx, listPageWithPolicies, arg.Cursor, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPageWithPoliciesRow
+	for rows.Next() {
+		var i ListPageWithPoliciesRow
+		if err := rows.Scan(
+			&i.Book.ID,
+			&i.Book.Isbn,
+			&i.Book.Title,
+			&i.Book.Author,
+			&i.Book.Genre,
+			&i.Book.Quantity,
+			&i.Book.PublicizedAt,
+			&i.PricePolicy.ID,
+			&i.PricePolicy.BookID,
+			&i.PricePolicy.Price,
+			&i.PricePolicy.StartDate,
+			&i.PricePolicy.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPaginating = `-- name: ListPaginating :many
-SELECT id, isbn, title, author, genre, quantity, publicized_at FROM books WHERE id > $1 LIMIT $2
+SELECT id, isbn, title, author, genre, quantity, publicized_at FROM books WHERE id > $1 ORDER BY id LIMIT $2
 `
 
 type ListPaginatingParams struct {
@@ -140,6 +566,261 @@ func (q *Queries) ListPaginating(ctx context.Context, arg ListPaginatingParams)
 	return items, nil
 }
 
+const listPoliciesByBook = `-- name: ListPoliciesByBook :many
+SELECT id, book_id, price, start_date, end_date FROM price_policies WHERE book_id = $1 ORDER BY id
+`
+
+func (q *Queries) ListPoliciesByBook(ctx context.Context, bookID pgtype.Int4) ([]PricePolicy, error) {
+	rows, err := q.db.Query(ctx, listPoliciesByBook, bookID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PricePolicy
+	for rows.Next() {
+		var i PricePolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Price,
+			&i.StartDate,
+			&i.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPoliciesByBooks = `-- name: ListPoliciesByBooks :many
+SELECT id, book_id, price, start_date, end_date FROM price_policies WHERE book_id = ANY($1::int[]) ORDER BY book_id, id
+`
+
+func (q *Queries) ListPoliciesByBooks(ctx context.Context, bookIds []int32) ([]PricePolicy, error) {
+	rows, err := q.db.Query(ctx, listPoliciesByBooks, bookIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PricePolicy
+	for rows.Next() {
+		var i PricePolicy
+		if err := rows.Scan(
+			&i.ID,
+			&i.BookID,
+			&i.Price,
+			&i.StartDate,
+			&i.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTaggedPaginating = `-- name: ListTaggedPaginating :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at, tags FROM tagged_books WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type ListTaggedPaginatingParams struct {
+	ID    int32
+	Limit int32
+}
+
+func (q *Queries) ListTaggedPaginating(ctx context.Context, arg ListTaggedPaginatingParams) ([]TaggedBook, error) {
+	rows, err := q.db.Query(ctx, listTaggedPaginating, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TaggedBook
+	for rows.Next() {
+		var i TaggedBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+			&i.Tags,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTitles = `-- name: ListTitles :many
+SELECT id, title FROM books WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type ListTitlesParams struct {
+	ID    int32
+	Limit int32
+}
+
+type ListTitlesRow struct {
+	ID    int32
+	Title string
+}
+
+func (q *Queries) ListTitles(ctx context.Context, arg ListTitlesParams) ([]ListTitlesRow, error) {
+	rows, err := q.db.Query(ctx, listTitles, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTitlesRow
+	for rows.Next() {
+		var i ListTitlesRow
+		if err := rows.Scan(&i.ID, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWithActivePolicy = `-- name: ListWithActivePolicy :many
+SELECT b.id, b.isbn, b.title, b.author, b.genre, b.quantity, b.publicized_at, pp.id, pp.book_id, pp.price, pp.start_date, pp.end_date
+FROM books b
+JOIN price_policies pp ON pp.book_id = b.id AND pp.start_date <= $1 AND pp.end_date > $1
+WHERE b.id > $2
+ORDER BY b.id
+LIMIT $3
+`
+
+type ListWithActivePolicyParams struct {
+	ActiveAt pgtype.Timestamp
+	Cursor   int32
+	PageSize int32
+}
+
+type ListWithActivePolicyRow struct {
+	Book        Book
+	PricePolicy PricePolicy
+}
+
+func (q *Queries) ListWithActivePolicy(ctx context.Context, arg ListWithActivePolicyParams) ([]ListWithActivePolicyRow, error) {
+	rows, err := q.db.Query(ctx, listWithActivePolicy, arg.ActiveAt, arg.Cursor, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWithActivePolicyRow
+	for rows.Next() {
+		var i ListWithActivePolicyRow
+		if err := rows.Scan(
+			&i.Book.ID,
+			&i.Book.Isbn,
+			&i.Book.Title,
+			&i.Book.Author,
+			&i.Book.Genre,
+			&i.Book.Quantity,
+			&i.Book.PublicizedAt,
+			&i.PricePolicy.ID,
+			&i.PricePolicy.BookID,
+			&i.PricePolicy.Price,
+			&i.PricePolicy.StartDate,
+			&i.PricePolicy.EndDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const search = `-- name: Search :many
+SELECT id, isbn, title, author, genre, quantity, publicized_at FROM books
+WHERE ($1::text IS NULL OR author LIKE $1 || '%')
+  AND ($2::text IS NULL OR genre = $2)
+  AND ($3::timestamp IS NULL OR publicized_at >= $3)
+  AND ($4::timestamp IS NULL OR publicized_at < $4)
+  AND ($5::int IS NULL OR quantity >= $5)
+  AND ($6::int IS NULL OR quantity <= $6)
+ORDER BY
+  CASE WHEN $7::text = 'title' AND NOT $8::bool THEN title END,
+  CASE WHEN $7::text = 'title' AND $8::bool THEN title END DESC,
+  CASE WHEN $7::text = 'publicized_at' AND NOT $8::bool THEN publicized_at END,
+  CASE WHEN $7::text = 'publicized_at' AND $8::bool THEN publicized_at END DESC,
+  CASE WHEN $7::text = 'quantity' AND NOT $8::bool THEN quantity END,
+  CASE WHEN $7::text = 'quantity' AND $8::bool THEN quantity END DESC,
+  CASE WHEN $7::text = 'id' AND $8::bool THEN id END DESC,
+  id
+LIMIT $9
+`
+
+type SearchParams struct {
+	AuthorPrefix    pgtype.Text
+	Genre           pgtype.Text
+	PublishedAfter  pgtype.Timestamp
+	PublishedBefore pgtype.Timestamp
+	MinQuantity     pgtype.Int4
+	MaxQuantity     pgtype.Int4
+	SortBy          string
+	Descending      bool
+	PageSize        int32
+}
+
+func (q *Queries) Search(ctx context.Context, arg SearchParams) ([]Book, error) {
+	rows, err := q.db.Query(ctx, search,
+		arg.AuthorPrefix,
+		arg.Genre,
+		arg.PublishedAfter,
+		arg.PublishedBefore,
+		arg.MinQuantity,
+		arg.MaxQuantity,
+		arg.SortBy,
+		arg.Descending,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Book
+	for rows.Next() {
+		var i Book
+		if err := rows.Scan(
+			&i.ID,
+			&i.Isbn,
+			&i.Title,
+			&i.Author,
+			&i.Genre,
+			&i.Quantity,
+			&i.PublicizedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const update = `-- name: Update :exec
 UPDATE books
 SET isbn = $1,
@@ -173,3 +854,88 @@ func (q *Queries) Update(ctx context.Context, arg UpdateParams) error {
 	)
 	return err
 }
+
+const updateNullable = `-- name: UpdateNullable :exec
+UPDATE nullable_books
+SET isbn = $1,
+    title = $2,
+    subtitle = $3,
+    description = $4,
+    author = $5,
+    genre = $6,
+    quantity = $7,
+    publicized_at = $8,
+    discontinued_at = $9
+WHERE id = $10
+`
+
+type UpdateNullableParams struct {
+	Isbn           string
+	Title          string
+	Subtitle       pgtype.Text
+	Description    pgtype.Text
+	Author         string
+	Genre          string
+	Quantity       int32
+	PublicizedAt   pgtype.Timestamp
+	DiscontinuedAt pgtype.Timestamp
+	ID             int32
+}
+
+func (q *Queries) UpdateNullable(ctx context.Context, arg UpdateNullableParams) error {
+	_, err := q.db.Exec(ctx, updateNullable,
+		arg.Isbn,
+		arg.Title,
+		arg.Subtitle,
+		arg.Description,
+		arg.Author,
+		arg.Genre,
+		arg.Quantity,
+		arg.PublicizedAt,
+		arg.DiscontinuedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const updateQuantityByGenre = `-- name: UpdateQuantityByGenre :exec
+UPDATE books SET quantity = $1 WHERE genre = $2
+`
+
+type UpdateQuantityByGenreParams struct {
+	Quantity int32
+	Genre    string
+}
+
+func (q *Queries) UpdateQuantityByGenre(ctx context.Context, arg UpdateQuantityByGenreParams) error {
+	_, err := q.db.Exec(ctx, updateQuantityByGenre, arg.Quantity, arg.Genre)
+	return err
+}
+
+const upsertMany = `-- name: UpsertMany :exec
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+SELECT unnest($1::varchar[]), unnest($2::varchar[]), unnest($3::varchar[]), unnest($4::varchar[]),
+       unnest($5::int[]), unnest($6::timestamp[])
+ON CONFLICT (isbn) DO UPDATE SET quantity = EXCLUDED.quantity
+`
+
+type UpsertManyParams struct {
+	Isbns         []string
+	Titles        []string
+	Authors       []string
+	Genres        []string
+	Quantities    []int32
+	PublicizedAts []pgtype.Timestamp
+}
+
+func (q *Queries) UpsertMany(ctx context.Context, arg UpsertManyParams) error {
+	_, err := q.db.Exec(ctx, upsertMany,
+		arg.Isbns,
+		arg.Titles,
+		arg.Authors,
+		arg.Genres,
+		arg.Quantities,
+		arg.PublicizedAts,
+	)
+	return err
+}
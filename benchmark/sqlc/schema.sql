@@ -6,4 +6,47 @@ CREATE TABLE IF NOT EXISTS books (
     genre VARCHAR(255) NOT NULL,
     quantity INTEGER NOT NULL,
     publicized_at TIMESTAMP NOT NULL
-);
\ No newline at end of file
+);
+
+CREATE TABLE IF NOT EXISTS price_policies (
+    id SERIAL PRIMARY KEY,
+    book_id INTEGER REFERENCES books(id) ON DELETE CASCADE,
+    price FLOAT NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS nullable_books (
+    id SERIAL PRIMARY KEY,
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    subtitle VARCHAR(255),
+    description TEXT,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    discontinued_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS json_books (
+    id SERIAL PRIMARY KEY,
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    metadata JSONB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS tagged_books (
+    id SERIAL PRIMARY KEY,
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    tags TEXT[] NOT NULL
+);
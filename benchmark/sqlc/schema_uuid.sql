@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS books (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS price_policies (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    book_id UUID REFERENCES books(id) ON DELETE CASCADE,
+    price FLOAT NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS nullable_books (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    subtitle VARCHAR(255),
+    description TEXT,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    discontinued_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS json_books (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    metadata JSONB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS tagged_books (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    tags TEXT[] NOT NULL
+);
@@ -0,0 +1,21 @@
+// Package sqlc holds the sqlc configuration and the code it generates into repository.
+package sqlc
+
+import (
+	_ "embed"
+	"regexp"
+)
+
+//go:embed repository/db.go
+var generated string
+
+var versionPattern = regexp.MustCompile(`sqlc (v\S+)`)
+
+// Version returns the sqlc version that generated the repository package. sqlc has no runtime
+// module, so it can't be read from the build info like the other libraries.
+func Version() string {
+	if match := versionPattern.FindStringSubmatch(generated); match != nil {
+		return match[1]
+	}
+	return ""
+}
@@ -0,0 +1,411 @@
+package benchmark
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/sqlc/repository"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/sqlc/uuidrepository"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SqlcUUIDBenchmark runs the operations of SqlcBenchmark on the UUIDv7 key variant, through the
+// queries sqlc generates into uuidrepository. The bulk updates and deletes and the aggregation, which
+// never read a key, are those of SqlcBenchmark. The parameters without a key are built by the helpers
+// of SqlcBenchmark and converted, as both repositories generate the same fields for them.
+type SqlcUUIDBenchmark struct {
+	SqlcBenchmark
+	uuidRepository *uuidrepository.Queries
+}
+
+func (s *SqlcUUIDBenchmark) Init() error {
+	if err := s.SqlcBenchmark.Init(); err != nil {
+		return err
+	}
+	s.uuidRepository = uuidrepository.New(s.db)
+	return nil
+}
+
+func (s *SqlcUUIDBenchmark) Insert(b *testing.B) {
+	book := model.NewBook[uuid.UUID]()
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				return s.uuidRepository.Create(s.ctx, uuidrepository.CreateParams(bookParams(book)))
+			},
+		}
+	})
+}
+
+func (s *SqlcUUIDBenchmark) InsertBulk(b *testing.B) {
+	books := model.NewBooks[uuid.UUID](utils.BulkInsertNumber)
+
+	batch := make([]uuidrepository.CreateManyParams, len(books))
+	for i, newBook := range books {
+		batch[i] = uuidrepository.CreateManyParams(bookParams(newBook))
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				_, err := s.uuidRepository.CreateMany(s.ctx, batch)
+				return err
+			},
+		}
+	})
+}
+
+func (s *SqlcUUIDBenchmark) Update(b *testing.B) {
+	run(b, func() step {
+		book := model.NewBook[uuid.UUID]()
+		ids, err := createBooks(1, s.createReturningID)
+		if err != nil {
+			b.Error(err)
+			return step{exec: func(int) error { return err }}
+		}
+		return step{
+			exec: func(int) error {
+				return s.uuidRepository.Update(s.ctx, uuidrepository.UpdateParams{
+					ID:           ids[0],
+					Isbn:         book.ISBN,
+					Title:        book.Title,
+					Author:       book.Author,
+					Genre:        book.Genre,
+					Quantity:     int32(book.Quantity),
+					PublicizedAt: pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+				})
+			},
+		}
+	})
+}
+
+func (s *SqlcUUIDBenchmark) Delete(b *testing.B) {
+	bookIDs, err := createBooks(b.N, s.createReturningID)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				return s.uuidRepository.Delete(s.ctx, bookIDs[i])
+			},
+		}
+	})
+}
+
+func (s *SqlcUUIDBenchmark) FindByID(b *testing.B) {
+	savedIDs, err := createBooks(b.N, s.createReturningID)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := s.uuidRepository.Get(s.ctx, savedIDs[i])
+				return err
+			},
+		}
+	})
+}
+
+func (s *SqlcUUIDBenchmark) FindPage(b *testing.B) {
+	bookIDs, err := createBooks(b.N, s.createReturningID)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := s.uuidRepository.ListPaginating(s.ctx, uuidrepository.ListPaginatingParams{
+					ID:    cursorAt(bookIDs, i),
+					Limit: int32(utils.PageSize),
+				})
+				return err
+			},
+		}
+	})
+}
+
+// createReturningID inserts a book with the params and returns the key the database generated.
+func (s *SqlcUUIDBenchmark) createReturningID(params repository.CreateReturningIDParams) (uuid.UUID, error) {
+	return s.uuidRepository.CreateReturningID(s.ctx, uuidrepository.CreateReturningIDParams(params))
+}
+
+func (s *SqlcUUIDBenchmark) FindWithActivePolicy(b *testing.B) {
+	now := time.Now().UTC()
+	keys, err := seedPricedBooks[uuid.UUID](b.N, now)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				_, err := s.uuidRepository.ListWithActivePolicy(s.ctx, uuidrepository.ListWithActivePolicyParams{
+					ActiveAt: pgtype.Timestamp{Time: now, Valid: true},
+					Cursor:   cursorAt(keys, i),
+					PageSize: int32(utils.PageSize),
+				})
+				return err
+			},
+		}
+	})
+}
+
+func (s *SqlcUUIDBenchmark) FindPageWithPolicies(strategy LoadStrategy) func(b *testing.B) {
+	page := func(cursor uuid.UUID) ([]uuidrepository.Book, error) {
+		return s.uuidRepository.ListPaginating(s.ctx, uuidrepository.ListPaginatingParams{
+			ID:    cursor,
+			Limit: int32(utils.PageSize),
+		})
+	}
+
+	switch strategy {
+	case NPlusOne:
+		return eagerBenchmark(func(cursor uuid.UUID) error {
+			books, err := page(cursor)
+			if err != nil {
+				return err
+			}
+			for _, book := range books {
+				_, err = s.uuidRepository.ListPoliciesByBook(s.ctx, pgtype.UUID{Bytes: book.ID, Valid: true})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	case Preload:
+		return eagerBenchmark(func(cursor uuid.UUID) error {
+			books, err := page(cursor)
+			if err != nil {
+				return err
+			}
+			ids := make([]uuid.UUID, len(books))
+			for j, book := range books {
+				ids[j] = book.ID
+			}
+			policies, err := s.uuidRepository.ListPoliciesByBooks(s.ctx, ids)
+			if err != nil {
+				return err
+			}
+			// Group the policies by book, as the other strategies do.
+			byBook := make(map[uuid.UUID][]uuidrepository.PricePolicy, len(books))
+			for _, policy := range policies {
+				byBook[policy.BookID.Bytes] = append(byBook[policy.BookID.Bytes], policy)
+			}
+			return nil
+		})
+	case Join:
+		return eagerBenchmark(func(cursor uuid.UUID) error {
+			rows, err := s.uuidRepository.ListPageWithPolicies(s.ctx, uuidrepository.ListPageWithPoliciesParams{
+				Cursor:   cursor,
+				PageSize: int32(utils.PageSize),
+			})
+			if err != nil {
+				return err
+			}
+			byBook := make(map[uuid.UUID][]uuidrepository.PricePolicy, utils.PageSize)
+			for _, row := range rows {
+				byBook[row.Book.ID] = append(byBook[row.Book.ID], row.PricePolicy)
+			}
+			return nil
+		})
+	}
+	return nil
+}
+
+func (s *SqlcUUIDBenchmark) Transaction(b *testing.B) {
+	s.transaction(b, false)
+}
+
+func (s *SqlcUUIDBenchmark) TransactionRollback(b *testing.B) {
+	s.transaction(b, true)
+}
+
+func (s *SqlcUUIDBenchmark) transaction(b *testing.B, rollback bool) {
+	now := time.Now().UTC()
+	book := model.NewBook[uuid.UUID]()
+
+	run(b, func() step {
+		return step{
+			exec: func(int) error {
+				tx, err := s.db.Begin(s.ctx)
+				if err != nil {
+					return err
+				}
+				// Rolling back a committed transaction is a no-op.
+				defer func() {
+					_ = tx.Rollback(s.ctx)
+				}()
+				queries := s.uuidRepository.WithTx(tx)
+
+				id, err := queries.CreateReturningID(s.ctx, uuidrepository.CreateReturningIDParams(bookParams(book)))
+				if err != nil {
+					return err
+				}
+
+				params := uuidrepository.CreatePricePoliciesParams{BookID: id}
+				params.Prices, params.StartDates, params.EndDates = pricePolicyParams(model.NewPricePolicies(id, now))
+				if err = queries.CreatePricePolicies(s.ctx, params); err != nil {
+					return err
+				}
+
+				if rollback {
+					return tx.Rollback(s.ctx)
+				}
+				return tx.Commit(s.ctx)
+			},
+		}
+	})
+}
+
+func (s *SqlcUUIDBenchmark) Upsert(b *testing.B) {
+	upsertBenchmark(b, func(books []*model.Book[uuid.UUID]) error {
+		return s.uuidRepository.UpsertMany(s.ctx, uuidrepository.UpsertManyParams(upsertParams(books)))
+	})
+}
+
+// FindByIDs only supports AnyArray, see SqlcBenchmark.FindByIDs.
+func (s *SqlcUUIDBenchmark) FindByIDs(list IDList) func(b *testing.B) {
+	if list != AnyArray {
+		return nil
+	}
+	return manyIDsBenchmark(func(ids []uuid.UUID) error {
+		_, err := s.uuidRepository.ListByIDs(s.ctx, ids)
+		return err
+	})
+}
+
+func (s *SqlcUUIDBenchmark) Search(b *testing.B) {
+	searchBenchmark(b, func(f searchFilter) error {
+		_, err := s.uuidRepository.Search(s.ctx, uuidrepository.SearchParams(searchParams(f)))
+		return err
+	})
+}
+
+func (s *SqlcUUIDBenchmark) FindPageAt(pagination Pagination, depth int) func(b *testing.B) {
+	return paginationBenchmark(depth, func(depth int, cursor uuid.UUID) error {
+		var err error
+		if pagination == Offset {
+			_, err = s.uuidRepository.ListOffset(s.ctx, uuidrepository.ListOffsetParams{
+				Offset: int32(depth),
+				Limit:  int32(utils.PageSize),
+			})
+		} else {
+			_, err = s.uuidRepository.ListPaginating(s.ctx, uuidrepository.ListPaginatingParams{
+				ID:    cursor,
+				Limit: int32(utils.PageSize),
+			})
+		}
+		return err
+	})
+}
+
+func (s *SqlcUUIDBenchmark) FindTitles(b *testing.B) {
+	projectionBenchmark(b, func(cursor uuid.UUID) error {
+		_, err := s.uuidRepository.ListTitles(s.ctx, uuidrepository.ListTitlesParams{
+			ID:    cursor,
+			Limit: int32(utils.PageSize),
+		})
+		return err
+	})
+}
+
+// ScanLarge has no streaming mode, see SqlcBenchmark.ScanLarge.
+func (s *SqlcUUIDBenchmark) ScanLarge(mode ScanMode) func(b *testing.B) {
+	if mode != Buffered {
+		return nil
+	}
+	return scanLargeBenchmark(func() error {
+		_, err := s.uuidRepository.ListAll(s.ctx)
+		return err
+	})
+}
+
+func (s *SqlcUUIDBenchmark) InsertNullable(b *testing.B) {
+	insertNullableBenchmark(b, func(book *model.NullableBook[uuid.UUID]) error {
+		return s.uuidRepository.CreateNullable(s.ctx, uuidrepository.CreateNullableParams(nullableParams(book)))
+	})
+}
+
+func (s *SqlcUUIDBenchmark) FindNullable(b *testing.B) {
+	findNullableBenchmark(b, func(cursor uuid.UUID) error {
+		_, err := s.uuidRepository.ListNullablePaginating(s.ctx, uuidrepository.ListNullablePaginatingParams{
+			ID:    cursor,
+			Limit: int32(utils.PageSize),
+		})
+		return err
+	})
+}
+
+func (s *SqlcUUIDBenchmark) UpdateNullable(b *testing.B) {
+	updateNullableBenchmark(b, func(book *model.NullableBook[uuid.UUID]) error {
+		return s.uuidRepository.UpdateNullable(s.ctx, uuidrepository.UpdateNullableParams{
+			ID:             book.ID,
+			Isbn:           book.ISBN,
+			Title:          book.Title,
+			Subtitle:       pgText(book.Subtitle),
+			Description:    pgtype.Text{String: book.Description.String, Valid: book.Description.Valid},
+			Author:         book.Author,
+			Genre:          book.Genre,
+			Quantity:       int32(book.Quantity),
+			PublicizedAt:   pgtype.Timestamp{Time: book.PublicizedAt, Valid: true},
+			DiscontinuedAt: pgTimestamp(book.DiscontinuedAt),
+		})
+	})
+}
+
+func (s *SqlcUUIDBenchmark) InsertJSON(size DocumentSize) func(b *testing.B) {
+	return insertJSONBenchmark(size, func(book *model.JSONBook[uuid.UUID]) error {
+		return s.uuidRepository.CreateJSON(s.ctx, uuidrepository.CreateJSONParams(jsonParams(book)))
+	})
+}
+
+func (s *SqlcUUIDBenchmark) FindJSON(size DocumentSize) func(b *testing.B) {
+	return findJSONBenchmark(size, func(cursor uuid.UUID) error {
+		_, err := s.uuidRepository.ListJSONPaginating(s.ctx, uuidrepository.ListJSONPaginatingParams{
+			ID:    cursor,
+			Limit: int32(utils.PageSize),
+		})
+		return err
+	})
+}
+
+func (s *SqlcUUIDBenchmark) InsertTags(b *testing.B) {
+	insertTagsBenchmark(b, func(book *model.TaggedBook[uuid.UUID]) error {
+		return s.uuidRepository.CreateTagged(s.ctx, uuidrepository.CreateTaggedParams(taggedParams(book)))
+	})
+}
+
+func (s *SqlcUUIDBenchmark) FindTags(b *testing.B) {
+	findTagsBenchmark(b, func(cursor uuid.UUID) error {
+		_, err := s.uuidRepository.ListTaggedPaginating(s.ctx, uuidrepository.ListTaggedPaginatingParams{
+			ID:    cursor,
+			Limit: int32(utils.PageSize),
+		})
+		return err
+	})
+}
+
+func (s *SqlcUUIDBenchmark) FilterTags(b *testing.B) {
+	filterTagsBenchmark[uuid.UUID](b, func(tags []string) error {
+		_, err := s.uuidRepository.ListByTags(s.ctx, uuidrepository.ListByTagsParams{
+			Tags:     tags,
+			PageSize: int32(utils.PageSize),
+		})
+		return err
+	})
+}
@@ -0,0 +1,117 @@
+package stats
+
+import (
+	"math"
+	"slices"
+)
+
+// Summary describes a sample of measurements taken from repeated benchmark runs.
+type Summary struct {
+	N      int     `json:"n"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	Stddev float64 `json:"stddev"`
+	// CV is the coefficient of variation, the standard deviation relative to the mean.
+	CV float64 `json:"cv"`
+	// CILow and CIHigh bound the 95% confidence interval of the mean.
+	CILow  float64 `json:"ci95_low"`
+	CIHigh float64 `json:"ci95_high"`
+}
+
+// Summarize computes the summary of values. The confidence interval is based on the
+// Student's t-distribution, so it is only meaningful with two or more values.
+func Summarize(values []float64) Summary {
+	s := Summary{N: len(values)}
+	if s.N == 0 {
+		return s
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	if s.N%2 == 1 {
+		s.Median = sorted[s.N/2]
+	} else {
+		s.Median = (sorted[s.N/2-1] + sorted[s.N/2]) / 2
+	}
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	s.Mean = sum / float64(s.N)
+
+	if s.N < 2 {
+		s.CILow, s.CIHigh = s.Mean, s.Mean
+		return s
+	}
+
+	var squares float64
+	for _, v := range values {
+		squares += (v - s.Mean) * (v - s.Mean)
+	}
+	s.Stddev = math.Sqrt(squares / float64(s.N-1))
+	if s.Mean != 0 {
+		s.CV = s.Stddev / s.Mean
+	}
+
+	margin := tCritical95(s.N-1) * s.Stddev / math.Sqrt(float64(s.N))
+	s.CILow, s.CIHigh = s.Mean-margin, s.Mean+margin
+	return s
+}
+
+// Overlaps reports whether the confidence intervals of both summaries intersect, in which
+// case there is no significant difference between them.
+func (s Summary) Overlaps(other Summary) bool {
+	return s.CILow <= other.CIHigh && other.CILow <= s.CIHigh
+}
+
+// CIMargin returns the half width of the confidence interval relative to the mean.
+func (s Summary) CIMargin() float64 {
+	if s.Mean == 0 {
+		return 0
+	}
+	return (s.CIHigh - s.Mean) / s.Mean
+}
+
+// Significant runs a two-tailed Welch's t-test at 95% confidence and reports whether the
+// means of a and b differ. Samples with a single value can't be tested and never differ.
+func Significant(a, b []float64) bool {
+	sa, sb := Summarize(a), Summarize(b)
+	if sa.N < 2 || sb.N < 2 {
+		return false
+	}
+
+	va := sa.Stddev * sa.Stddev / float64(sa.N)
+	vb := sb.Stddev * sb.Stddev / float64(sb.N)
+	if va+vb == 0 {
+		// Deterministic measurements, such as allocations, differ whenever their means do.
+		return sa.Mean != sb.Mean
+	}
+
+	t := math.Abs(sa.Mean-sb.Mean) / math.Sqrt(va+vb)
+	df := (va + vb) * (va + vb) / (va*va/float64(sa.N-1) + vb*vb/float64(sb.N-1))
+	return t > tCritical95(int(df))
+}
+
+// tTable holds the two-tailed 95% critical values of the t-distribution, indexed by degrees of freedom.
+var tTable = []float64{
+	math.Inf(1),
+	12.706, 4.303, 3.182, 2.776, 2.571, 2.447, 2.365, 2.306, 2.262, 2.228,
+	2.201, 2.179, 2.160, 2.145, 2.131, 2.120, 2.110, 2.101, 2.093, 2.086,
+	2.080, 2.074, 2.069, 2.064, 2.060, 2.056, 2.052, 2.048, 2.045, 2.042,
+}
+
+func tCritical95(df int) float64 {
+	switch {
+	case df < len(tTable):
+		return tTable[df]
+	case df < 40:
+		return 2.042
+	case df < 60:
+		return 2.021
+	case df < 120:
+		return 2.000
+	default:
+		return 1.960
+	}
+}
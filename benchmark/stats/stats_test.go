@@ -0,0 +1,187 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTCritical95(t *testing.T) {
+	tests := []struct {
+		df   int
+		want float64
+	}{
+		{df: 0, want: math.Inf(1)},
+		{df: 1, want: 12.706},
+		{df: 2, want: 4.303},
+		{df: 10, want: 2.228},
+		{df: 30, want: 2.042},
+		{df: 31, want: 2.042},
+		{df: 39, want: 2.042},
+		{df: 40, want: 2.021},
+		{df: 59, want: 2.021},
+		{df: 60, want: 2.000},
+		{df: 119, want: 2.000},
+		{df: 120, want: 1.960},
+		{df: 10000, want: 1.960},
+	}
+	for _, tt := range tests {
+		if got := tCritical95(tt.df); got != tt.want {
+			t.Errorf("tCritical95(%d) = %v, want %v", tt.df, got, tt.want)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   Summary
+	}{
+		{
+			name: "empty",
+			want: Summary{},
+		},
+		{
+			name:   "single value",
+			values: []float64{5},
+			want:   Summary{N: 1, Mean: 5, Median: 5, CILow: 5, CIHigh: 5},
+		},
+		{
+			name:   "even count",
+			values: []float64{4, 1, 3, 2},
+			want: Summary{
+				N: 4, Mean: 2.5, Median: 2.5, Stddev: math.Sqrt(5.0 / 3), CV: math.Sqrt(5.0/3) / 2.5,
+				CILow: 2.5 - 3.182*math.Sqrt(5.0/3)/2, CIHigh: 2.5 + 3.182*math.Sqrt(5.0/3)/2,
+			},
+		},
+		{
+			name:   "odd count",
+			values: []float64{10, 30, 20},
+			want: Summary{
+				N: 3, Mean: 20, Median: 20, Stddev: 10, CV: 0.5,
+				CILow: 20 - 4.303*10/math.Sqrt(3), CIHigh: 20 + 4.303*10/math.Sqrt(3),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Summarize(tt.values)
+			if got.N != tt.want.N {
+				t.Fatalf("N = %d, want %d", got.N, tt.want.N)
+			}
+			for _, f := range []struct {
+				name      string
+				got, want float64
+			}{
+				{"Mean", got.Mean, tt.want.Mean},
+				{"Median", got.Median, tt.want.Median},
+				{"Stddev", got.Stddev, tt.want.Stddev},
+				{"CV", got.CV, tt.want.CV},
+				{"CILow", got.CILow, tt.want.CILow},
+				{"CIHigh", got.CIHigh, tt.want.CIHigh},
+			} {
+				if math.Abs(f.got-f.want) > 1e-9 {
+					t.Errorf("%s = %v, want %v", f.name, f.got, f.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSignificant(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []float64
+		want bool
+	}{
+		{
+			name: "single value",
+			a:    []float64{100},
+			b:    []float64{200, 201, 202},
+			want: false,
+		},
+		{
+			name: "same deterministic values",
+			a:    []float64{7, 7, 7},
+			b:    []float64{7, 7},
+			want: false,
+		},
+		{
+			name: "different deterministic values",
+			a:    []float64{7, 7, 7},
+			b:    []float64{8, 8, 8},
+			want: true,
+		},
+		{
+			name: "overlapping noise",
+			a:    []float64{100, 110, 90, 105, 95},
+			b:    []float64{102, 112, 92, 98, 101},
+			want: false,
+		},
+		{
+			name: "clear shift",
+			a:    []float64{100, 101, 99, 100, 102},
+			b:    []float64{120, 121, 119, 122, 118},
+			want: true,
+		},
+		{
+			name: "unequal variances",
+			a:    []float64{100, 100.5, 99.5, 100, 100.2, 99.8},
+			b:    []float64{90, 140, 60, 150, 70},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Significant(tt.a, tt.b); got != tt.want {
+				t.Errorf("Significant(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if got := Significant(tt.b, tt.a); got != tt.want {
+				t.Errorf("Significant(%v, %v) = %v, want %v", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Summary
+		want bool
+	}{
+		{
+			name: "disjoint",
+			a:    Summary{CILow: 1, CIHigh: 2},
+			b:    Summary{CILow: 3, CIHigh: 4},
+			want: false,
+		},
+		{
+			name: "intersecting",
+			a:    Summary{CILow: 1, CIHigh: 3},
+			b:    Summary{CILow: 2, CIHigh: 4},
+			want: true,
+		},
+		{
+			name: "touching",
+			a:    Summary{CILow: 1, CIHigh: 2},
+			b:    Summary{CILow: 2, CIHigh: 3},
+			want: true,
+		},
+		{
+			name: "contained",
+			a:    Summary{CILow: 1, CIHigh: 10},
+			b:    Summary{CILow: 4, CIHigh: 5},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Overlaps(tt.b); got != tt.want {
+				t.Errorf("a.Overlaps(b) = %v, want %v", got, tt.want)
+			}
+			if got := tt.b.Overlaps(tt.a); got != tt.want {
+				t.Errorf("b.Overlaps(a) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package benchmark
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+var taggedColumns = []string{"isbn", "title", "author", "genre", "quantity", "publicized_at", "tags"}
+
+// insertTagsBenchmark measures insert with books of the TEXT[] variant.
+func insertTagsBenchmark[K model.Key](b *testing.B, insert func(book *model.TaggedBook[K]) error) {
+	run(b, func() step {
+		book := model.NewTaggedBooks[K](1)[0]
+		var zero K
+		return step{
+			prepare: func(int) {
+				book.ID = zero
+			},
+			exec: func(int) error {
+				return insert(book)
+			},
+		}
+	})
+}
+
+// findTagsBenchmark seeds the books of the TEXT[] variant, then measures find fetching the page
+// after the cursor of the iteration, like FindPage does.
+func findTagsBenchmark[K model.Key](b *testing.B, find func(cursor K) error) {
+	if err := truncateTaggedBooks(); err != nil {
+		b.Error(err)
+		return
+	}
+	ids, err := seedTaggedBooks[K](b.N)
+	if err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		return step{
+			exec: func(i int) error {
+				return find(cursorAt(ids, i))
+			},
+		}
+	})
+}
+
+// filterTagsBenchmark seeds utils.BulkInsertNumber books of the TEXT[] variant, then measures
+// filter fetching the first page of books having two consecutive model.Tags picked at random,
+// which a third of the books have.
+func filterTagsBenchmark[K model.Key](b *testing.B, filter func(tags []string) error) {
+	if err := truncateTaggedBooks(); err != nil {
+		b.Error(err)
+		return
+	}
+	if _, err := seedTaggedBooks[K](utils.BulkInsertNumber); err != nil {
+		b.Error(err)
+		return
+	}
+
+	run(b, func() step {
+		rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+		tags := make([]string, 2)
+		return step{
+			prepare: func(int) {
+				first := rng.Intn(len(model.Tags))
+				tags[0] = model.Tags[first]
+				tags[1] = model.Tags[(first+1)%len(model.Tags)]
+			},
+			exec: func(int) error {
+				return filter(tags)
+			},
+		}
+	})
+}
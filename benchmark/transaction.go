@@ -0,0 +1,24 @@
+package benchmark
+
+import (
+	"errors"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// errRollback is returned inside the transactions of the rollback variant, to undo them.
+var errRollback = errors.New("rollback")
+
+// policyColumns splits the policies into the arrays of utils.InsertPricePoliciesQuery.
+func policyColumns[K model.Key](policies []*model.PricePolicy[K]) (prices []float64, startDates, endDates []time.Time) {
+	prices = make([]float64, len(policies))
+	startDates = make([]time.Time, len(policies))
+	endDates = make([]time.Time, len(policies))
+	for i, policy := range policies {
+		prices[i] = policy.Price
+		startDates[i] = policy.StartDate
+		endDates[i] = policy.EndDate
+	}
+	return prices, startDates, endDates
+}
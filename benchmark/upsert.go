@@ -0,0 +1,59 @@
+package benchmark
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+	"github.com/andreiac-silva/golang-orm-benchmarks/model"
+)
+
+// upsertBenchmark measures upsert with batches of utils.BulkInsertNumber books into the table
+// variant where ISBNs are unique. The first half of every batch takes the ISBNs of books seeded
+// for the goroutine, so it conflicts and updates their quantity, while the second half is new.
+func upsertBenchmark[K model.Key](b *testing.B, upsert func(books []*model.Book[K]) error) {
+	run(b, func() step {
+		conflicting := utils.BulkInsertNumber / 2
+		books, err := seedUniqueBooks[K](conflicting)
+		if err != nil {
+			b.Error(err)
+			return step{exec: func(int) error { return err }}
+		}
+		books = append(books, model.NewBooks[K](utils.BulkInsertNumber-conflicting)...)
+		var zero K
+		return step{
+			prepare: func(i int) {
+				for j, book := range books {
+					book.ID = zero
+					if j < conflicting {
+						book.Quantity = i
+					} else {
+						book.ISBN = model.NextISBN()
+					}
+				}
+			},
+			exec: func(int) error {
+				return upsert(books)
+			},
+		}
+	})
+}
+
+// bookColumns splits the books into the arrays of utils.UpsertArraysQuery.
+func bookColumns[K model.Key](books []*model.Book[K]) (isbns, titles, authors, genres []string, quantities []int, publicizedAts []time.Time) {
+	isbns = make([]string, len(books))
+	titles = make([]string, len(books))
+	authors = make([]string, len(books))
+	genres = make([]string, len(books))
+	quantities = make([]int, len(books))
+	publicizedAts = make([]time.Time, len(books))
+	for i, book := range books {
+		isbns[i] = book.ISBN
+		titles[i] = book.Title
+		authors[i] = book.Author
+		genres[i] = book.Genre
+		quantities[i] = book.Quantity
+		publicizedAts[i] = book.PublicizedAt
+	}
+	return isbns, titles, authors, genres, quantities, publicizedAts
+}
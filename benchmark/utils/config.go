@@ -1,21 +1,304 @@
 package utils
 
 import (
+	"errors"
+	"flag"
+	"fmt"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxBindParameters is the number of placeholders PostgreSQL accepts in a single statement.
+const maxBindParameters = 65535
+
+// MaxBooksPerStatement is the number of books a multi-row INSERT takes at most, as each book takes
+// 6 bind parameters.
+const MaxBooksPerStatement = maxBindParameters / 6
+
+var defaultConfig = Config{
+	BulkInsertNumber: 2000,
+	BatchSize:        10000,
+	PageSize:         10,
+	IDsNumber:        100,
+	Benchtime:        "1s",
+	Operations:       []string{"select-one"},
+	Orms:             []string{"all"},
+	PoolSize:         0,
+	Parallelism:      1,
+	Count:            1,
+	PrimaryKey:       SerialKey,
+
+	SmallDocumentReviews:  0,
+	MediumDocumentReviews: 10,
+	LargeDocumentReviews:  250,
+}
+
+// These settings are overridden by Config.Apply.
+var (
+	BulkInsertNumber = defaultConfig.BulkInsertNumber
+	BatchSize        = defaultConfig.BatchSize
+	PageSize         = defaultConfig.PageSize
+	IDsNumber        = defaultConfig.IDsNumber
 )
 
-// TODO: Add these ones to the .env file.
+// PostgresDSN is read from the environment; main requires it before running any benchmark.
+var PostgresDSN = os.Getenv("POSTGRES_DSN")
+
+// Parallelism is the number of goroutines that share each benchmark's iterations.
+var Parallelism = defaultConfig.Parallelism
+
+// PoolSize is the maximum number of connections of each adapter, zero meaning one per goroutine.
+var PoolSize = defaultConfig.PoolSize
+
+// Primary keys of the books and price_policies tables, see PrimaryKey.
 const (
-	BulkInsertNumber = 2000
-	BatchSize        = 10000
-	PageSize         = 10
+	SerialKey = "serial"
+	UUIDKey   = "uuid"
 )
 
-var PostgresDSN string
+// PrimaryKeys lists every primary key of the schema.
+var PrimaryKeys = []string{SerialKey, UUIDKey}
+
+// PrimaryKey selects the schema variant of the benchmarks: SERIAL keys, or UUIDv7 ones.
+var PrimaryKey = defaultConfig.PrimaryKey
+
+// The number of reviews in the metadata documents of each size of the JSONB variant.
+var (
+	SmallDocumentReviews  = defaultConfig.SmallDocumentReviews
+	MediumDocumentReviews = defaultConfig.MediumDocumentReviews
+	LargeDocumentReviews  = defaultConfig.LargeDocumentReviews
+)
+
+// Config holds the tunable settings of an execution. Each setting is resolved with the following
+// precedence, from highest to lowest: command-line flag, environment variable, config file, default.
+type Config struct {
+	BulkInsertNumber int      `yaml:"bulk_insert_number" json:"bulk_insert_number"`
+	BatchSize        int      `yaml:"batch_size" json:"batch_size"`
+	PageSize         int      `yaml:"page_size" json:"page_size"`
+	IDsNumber        int      `yaml:"ids_number" json:"ids_number"`
+	Benchtime        string   `yaml:"benchtime" json:"benchtime"`
+	Operations       []string `yaml:"operations" json:"operations"`
+	Orms             []string `yaml:"orms" json:"orms"`
+	// PoolSize caps the connections of every adapter; zero keeps one per goroutine.
+	PoolSize    int `yaml:"pool_size" json:"pool_size"`
+	Parallelism int `yaml:"parallelism" json:"parallelism"`
+	Count       int `yaml:"count" json:"count"`
+	// PrimaryKey is the type of key of the books and their price policies, one of PrimaryKeys.
+	PrimaryKey string `yaml:"primary_key" json:"primary_key"`
+	// SmallDocumentReviews and the following ones size the metadata documents of the JSONB variant,
+	// by their number of reviews.
+	SmallDocumentReviews  int `yaml:"small_document_reviews" json:"small_document_reviews"`
+	MediumDocumentReviews int `yaml:"medium_document_reviews" json:"medium_document_reviews"`
+	LargeDocumentReviews  int `yaml:"large_document_reviews" json:"large_document_reviews"`
+}
+
+// Setting keys double as the command-line flag names.
+const (
+	BulkInsertNumberKey = "bulk-insert-number"
+	BatchSizeKey        = "batch-size"
+	PageSizeKey         = "page-size"
+	IDsNumberKey        = "ids-number"
+	BenchtimeKey        = "benchtime"
+	OperationKey        = "operation"
+	OrmKey              = "orm"
+	PoolSizeKey         = "pool-size"
+	ParallelismKey      = "parallelism"
+	CountKey            = "count"
+	PrimaryKeyKey       = "primary-key"
+
+	SmallDocumentReviewsKey  = "small-document-reviews"
+	MediumDocumentReviewsKey = "medium-document-reviews"
+	LargeDocumentReviewsKey  = "large-document-reviews"
+)
+
+// envVars maps every setting key to its environment variable.
+var envVars = map[string]string{
+	BulkInsertNumberKey: "BULK_INSERT_NUMBER",
+	BatchSizeKey:        "BATCH_SIZE",
+	PageSizeKey:         "PAGE_SIZE",
+	IDsNumberKey:        "IDS_NUMBER",
+	BenchtimeKey:        "BENCHTIME",
+	OperationKey:        "OPERATIONS",
+	OrmKey:              "ORMS",
+	PoolSizeKey:         "POOL_SIZE",
+	ParallelismKey:      "PARALLELISM",
+	CountKey:            "COUNT",
+	PrimaryKeyKey:       "PRIMARY_KEY",
+
+	SmallDocumentReviewsKey:  "SMALL_DOCUMENT_REVIEWS",
+	MediumDocumentReviewsKey: "MEDIUM_DOCUMENT_REVIEWS",
+	LargeDocumentReviewsKey:  "LARGE_DOCUMENT_REVIEWS",
+}
+
+func DefaultConfig() Config {
+	c := defaultConfig
+	c.Operations = slices.Clone(defaultConfig.Operations)
+	c.Orms = slices.Clone(defaultConfig.Orms)
+	return c
+}
+
+// LoadFile overrides the settings present in the YAML file at path.
+func (c *Config) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(data, c)
+}
+
+// LoadEnv overrides the settings whose environment variable is set.
+func (c *Config) LoadEnv() error {
+	for key, env := range envVars {
+		value, ok := os.LookupEnv(env)
+		if !ok || value == "" {
+			continue
+		}
+		if err := c.Set(key, value); err != nil {
+			return fmt.Errorf("%s: %w", env, err)
+		}
+	}
+	return nil
+}
+
+// LoadFlags overrides the settings whose flag was explicitly passed on the command line.
+func (c *Config) LoadFlags(fs *flag.FlagSet) error {
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		if _, ok := envVars[f.Name]; ok && err == nil {
+			if setErr := c.Set(f.Name, f.Value.String()); setErr != nil {
+				err = fmt.Errorf("-%s: %w", f.Name, setErr)
+			}
+		}
+	})
+	return err
+}
+
+// Set parses the value of the setting identified by key.
+func (c *Config) Set(key, value string) error {
+	var err error
+	switch key {
+	case BulkInsertNumberKey:
+		c.BulkInsertNumber, err = strconv.Atoi(value)
+	case BatchSizeKey:
+		c.BatchSize, err = strconv.Atoi(value)
+	case PageSizeKey:
+		c.PageSize, err = strconv.Atoi(value)
+	case IDsNumberKey:
+		c.IDsNumber, err = strconv.Atoi(value)
+	case BenchtimeKey:
+		c.Benchtime = value
+	case OperationKey:
+		c.Operations = splitList(value)
+	case OrmKey:
+		c.Orms = splitList(value)
+	case PoolSizeKey:
+		c.PoolSize, err = strconv.Atoi(value)
+	case ParallelismKey:
+		c.Parallelism, err = strconv.Atoi(value)
+	case CountKey:
+		c.Count, err = strconv.Atoi(value)
+	case PrimaryKeyKey:
+		c.PrimaryKey = value
+	case SmallDocumentReviewsKey:
+		c.SmallDocumentReviews, err = strconv.Atoi(value)
+	case MediumDocumentReviewsKey:
+		c.MediumDocumentReviews, err = strconv.Atoi(value)
+	case LargeDocumentReviewsKey:
+		c.LargeDocumentReviews, err = strconv.Atoi(value)
+	default:
+		err = fmt.Errorf("unknown setting %q", key)
+	}
+	return err
+}
+
+func (c *Config) Validate() error {
+	switch {
+	case c.BulkInsertNumber < 1:
+		return errors.New("bulk insert number must be greater than zero")
+	case c.BatchSize < 1:
+		return errors.New("batch size must be greater than zero")
+	case c.BatchSize > MaxBooksPerStatement:
+		return fmt.Errorf("batch size must be at most %d, as each book takes 6 bind parameters", MaxBooksPerStatement)
+	case c.PageSize < 1:
+		return errors.New("page size must be greater than zero")
+	case c.IDsNumber < 1:
+		return errors.New("ids number must be greater than zero")
+	case c.IDsNumber > maxBindParameters:
+		return fmt.Errorf("ids number must be at most %d, as each ID of an IN list takes a bind parameter", maxBindParameters)
+	case c.PoolSize < 0:
+		return errors.New("pool size must not be negative")
+	case c.Parallelism < 1:
+		return errors.New("parallelism must be greater than zero")
+	case c.Count < 1:
+		return errors.New("count must be greater than zero")
+	case len(c.Operations) == 0:
+		return errors.New("at least one operation is required")
+	case len(c.Orms) == 0:
+		return errors.New("at least one orm is required")
+	case !slices.Contains(PrimaryKeys, c.PrimaryKey):
+		return fmt.Errorf("primary key must be one of %s", strings.Join(PrimaryKeys, ", "))
+	case c.SmallDocumentReviews < 0:
+		return errors.New("small document reviews must not be negative")
+	case c.MediumDocumentReviews < c.SmallDocumentReviews:
+		return errors.New("medium document reviews must not be fewer than the small document ones")
+	case c.LargeDocumentReviews < c.MediumDocumentReviews:
+		return errors.New("large document reviews must not be fewer than the medium document ones")
+	}
+	return nil
+}
+
+// Apply makes the settings effective for the benchmarks.
+func (c *Config) Apply() error {
+	BulkInsertNumber = c.BulkInsertNumber
+	BatchSize = c.BatchSize
+	PageSize = c.PageSize
+	IDsNumber = c.IDsNumber
+	Parallelism = c.Parallelism
+	PoolSize = c.PoolSize
+	PrimaryKey = c.PrimaryKey
+	SmallDocumentReviews = c.SmallDocumentReviews
+	MediumDocumentReviews = c.MediumDocumentReviews
+	LargeDocumentReviews = c.LargeDocumentReviews
+
+	// testing.Benchmark reads the benchmark time from the flags of the testing package.
+	testing.Init()
+	if err := flag.Set("test.benchtime", c.Benchtime); err != nil {
+		return fmt.Errorf("invalid benchtime %q: %w", c.Benchtime, err)
+	}
+	return nil
+}
+
+// Pairs lists the settings as key and value pairs, in a stable order.
+func (c *Config) Pairs() [][2]string {
+	return [][2]string{
+		{BulkInsertNumberKey, strconv.Itoa(c.BulkInsertNumber)},
+		{BatchSizeKey, strconv.Itoa(c.BatchSize)},
+		{PageSizeKey, strconv.Itoa(c.PageSize)},
+		{IDsNumberKey, strconv.Itoa(c.IDsNumber)},
+		{BenchtimeKey, c.Benchtime},
+		{OperationKey, strings.Join(c.Operations, ",")},
+		{OrmKey, strings.Join(c.Orms, ",")},
+		{PoolSizeKey, strconv.Itoa(c.PoolSize)},
+		{ParallelismKey, strconv.Itoa(c.Parallelism)},
+		{CountKey, strconv.Itoa(c.Count)},
+		{PrimaryKeyKey, c.PrimaryKey},
+		{SmallDocumentReviewsKey, strconv.Itoa(c.SmallDocumentReviews)},
+		{MediumDocumentReviewsKey, strconv.Itoa(c.MediumDocumentReviews)},
+		{LargeDocumentReviewsKey, strconv.Itoa(c.LargeDocumentReviews)},
+	}
+}
 
-func init() {
-	PostgresDSN = os.Getenv("POSTGRES_DSN")
-	if PostgresDSN == "" {
-		panic("POSTGRES_DSN is required")
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
 	}
+	return items
 }
@@ -0,0 +1,66 @@
+package utils
+
+import "testing"
+
+func TestValidateBindParameters(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     func(c *Config)
+		wantErr bool
+	}{
+		{name: "defaults", set: func(*Config) {}},
+		{name: "bulk insert over the statement limit", set: func(c *Config) { c.BulkInsertNumber = 50000 }},
+		{name: "largest batch", set: func(c *Config) { c.BatchSize = 10922 }},
+		{name: "batch over the limit", set: func(c *Config) { c.BatchSize = 10923 }, wantErr: true},
+		{name: "largest ids number", set: func(c *Config) { c.IDsNumber = 65535 }},
+		{name: "ids number over the limit", set: func(c *Config) { c.IDsNumber = 65536 }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := DefaultConfig()
+			tt.set(&c)
+			if err := c.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDocumentReviews(t *testing.T) {
+	tests := []struct {
+		name                 string
+		small, medium, large int
+		wantErr              bool
+	}{
+		{name: "defaults", small: 0, medium: 10, large: 250},
+		{name: "equal sizes", small: 5, medium: 5, large: 5},
+		{name: "larger documents", small: 10, medium: 100, large: 5000},
+		{name: "negative small", small: -1, medium: 10, large: 250, wantErr: true},
+		{name: "medium below small", small: 10, medium: 5, large: 250, wantErr: true},
+		{name: "large below medium", small: 0, medium: 10, large: 5, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := DefaultConfig()
+			c.SmallDocumentReviews, c.MediumDocumentReviews, c.LargeDocumentReviews = tt.small, tt.medium, tt.large
+			if err := c.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadDocumentReviews(t *testing.T) {
+	t.Setenv("MEDIUM_DOCUMENT_REVIEWS", "20")
+	c := DefaultConfig()
+	if err := c.LoadEnv(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Set(LargeDocumentReviewsKey, "1000"); err != nil {
+		t.Fatal(err)
+	}
+	if c.SmallDocumentReviews != 0 || c.MediumDocumentReviews != 20 || c.LargeDocumentReviews != 1000 {
+		t.Errorf("document reviews = %d, %d, %d, want 0, 20, 1000",
+			c.SmallDocumentReviews, c.MediumDocumentReviews, c.LargeDocumentReviews)
+	}
+}
@@ -1,11 +1,15 @@
 package utils
 
 import (
+	"context"
 	"database/sql"
 	"log"
 
 	queries "github.com/andreiac-silva/golang-orm-benchmarks/sql"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
 	// Postgres driver.
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
@@ -24,4 +28,60 @@ func RecreateDatabase() {
 	if err != nil {
 		log.Fatal("the benchmark execution was aborted", err)
 	}
+
+	if PrimaryKey == UUIDKey {
+		if _, err = db.Exec(queries.UUIDKeySQL); err != nil {
+			log.Fatal("the benchmark execution was aborted", err)
+		}
+	}
+}
+
+// ConfigurePool keeps one idle connection per benchmark goroutine, so parallel runs
+// don't reconnect after every operation. When PoolSize is set, it caps the open connections.
+func ConfigurePool(db *sql.DB) {
+	if PoolSize > 0 {
+		db.SetMaxOpenConns(PoolSize)
+		db.SetMaxIdleConns(PoolSize)
+		return
+	}
+	db.SetMaxIdleConns(max(Parallelism, 2))
+}
+
+// PgxConn is the part of pgx.Conn and pgxpool.Pool used by the pgx and sqlc benchmarks.
+type PgxConn interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// NewPgxConn opens a single pgx connection when the benchmarks run serially, keeping the
+// serial results comparable with earlier ones. Otherwise, it opens a pool with at least
+// one connection per benchmark goroutine, or exactly PoolSize connections when it is set.
+func NewPgxConn(ctx context.Context) (PgxConn, error) {
+	if Parallelism == 1 {
+		return pgx.Connect(ctx, PostgresDSN)
+	}
+
+	config, err := pgxpool.ParseConfig(PostgresDSN)
+	if err != nil {
+		return nil, err
+	}
+	config.MaxConns = max(config.MaxConns, int32(Parallelism))
+	if PoolSize > 0 {
+		config.MaxConns = int32(PoolSize)
+	}
+	return pgxpool.NewWithConfig(ctx, config)
+}
+
+// ClosePgxConn closes a connection opened by NewPgxConn.
+func ClosePgxConn(ctx context.Context, conn PgxConn) error {
+	switch c := conn.(type) {
+	case *pgx.Conn:
+		return c.Close(ctx)
+	case *pgxpool.Pool:
+		c.Close()
+	}
+	return nil
 }
@@ -17,4 +17,50 @@ var (
 	SelectByIDQuery string
 	//go:embed sql/select_paginating.sql
 	SelectPaginatingQuery string
+	//go:embed sql/select_join.sql
+	SelectJoinQuery string
+	//go:embed sql/select_policies_by_book.sql
+	SelectPoliciesByBookQuery string
+	//go:embed sql/select_policies_by_books.sql
+	SelectPoliciesByBooksQuery string
+	//go:embed sql/select_eager_join.sql
+	SelectEagerJoinQuery string
+	//go:embed sql/insert_price_policies.sql
+	InsertPricePoliciesQuery string
+	//go:embed sql/upsert_bulk.sql
+	UpsertBulkQuery string
+	//go:embed sql/upsert_arrays.sql
+	UpsertArraysQuery string
+	//go:embed sql/update_by_genre.sql
+	UpdateByGenreQuery string
+	//go:embed sql/delete_by_genre.sql
+	DeleteByGenreQuery string
+	//go:embed sql/select_by_ids_in.sql
+	SelectByIDsInQuery string
+	//go:embed sql/select_by_ids_any.sql
+	SelectByIDsAnyQuery string
+	//go:embed sql/select_genre_stats.sql
+	SelectGenreStatsQuery string
+	//go:embed sql/select_offset.sql
+	SelectOffsetQuery string
+	//go:embed sql/select_titles.sql
+	SelectTitlesQuery string
+	//go:embed sql/select_all.sql
+	SelectAllQuery string
+	//go:embed sql/insert_nullable.sql
+	InsertNullableQuery string
+	//go:embed sql/update_nullable.sql
+	UpdateNullableQuery string
+	//go:embed sql/select_nullable_paginating.sql
+	SelectNullablePaginatingQuery string
+	//go:embed sql/insert_json.sql
+	InsertJSONQuery string
+	//go:embed sql/select_json_paginating.sql
+	SelectJSONPaginatingQuery string
+	//go:embed sql/insert_tagged.sql
+	InsertTaggedQuery string
+	//go:embed sql/select_tagged_paginating.sql
+	SelectTaggedPaginatingQuery string
+	//go:embed sql/select_by_tags.sql
+	SelectByTagsQuery string
 )
@@ -0,0 +1,10 @@
+-- insertJSONBook
+-- $1 ISBN
+-- $2 Title
+-- $3 Author
+-- $4 Genre
+-- $5 Quantity
+-- $6 Publishing date
+-- $7 Metadata document
+INSERT INTO json_books (isbn, title, author, genre, quantity, publicized_at, metadata)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
@@ -0,0 +1,12 @@
+-- insertNullableBook
+-- $1 ISBN
+-- $2 Title
+-- $3 Subtitle, or NULL
+-- $4 Description, or NULL
+-- $5 Author
+-- $6 Genre
+-- $7 Quantity
+-- $8 Publishing date
+-- $9 Discontinuation date, or NULL
+INSERT INTO nullable_books (isbn, title, subtitle, description, author, genre, quantity, publicized_at, discontinued_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
@@ -0,0 +1,7 @@
+-- insertPricePolicies
+-- $1 Book ID
+-- $2 Prices
+-- $3 Start dates
+-- $4 End dates
+INSERT INTO price_policies (book_id, price, start_date, end_date)
+SELECT $1, unnest($2::float8[]), unnest($3::timestamp[]), unnest($4::timestamp[]);
@@ -0,0 +1,2 @@
+-- selectAll
+SELECT * FROM books;
@@ -0,0 +1,3 @@
+-- selectByIDsAny
+-- $1 IDs
+SELECT * FROM books WHERE id = ANY($1);
@@ -0,0 +1,3 @@
+-- selectByIDsIn
+-- $1... IDs, one placeholder each
+SELECT * FROM books WHERE id IN (%s);
@@ -0,0 +1,4 @@
+-- selectByTags
+-- $1 Tags every book must have
+-- $2 Limit
+SELECT * FROM tagged_books WHERE tags @> $1 ORDER BY id LIMIT $2;
@@ -0,0 +1,9 @@
+-- selectPageWithPolicies
+-- $1 Cursor
+-- $2 Limit
+SELECT b.id, b.isbn, b.title, b.author, b.genre, b.quantity, b.publicized_at,
+       pp.id AS policy_id, pp.price, pp.start_date, pp.end_date
+FROM books b
+JOIN price_policies pp ON pp.book_id = b.id
+WHERE b.id IN (SELECT id FROM books WHERE id > $1 ORDER BY id LIMIT $2)
+ORDER BY b.id, pp.id;
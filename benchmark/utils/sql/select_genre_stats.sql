@@ -0,0 +1,4 @@
+-- selectGenreStats
+SELECT genre, COUNT(*) AS books, SUM(quantity) AS total_quantity, AVG(quantity) AS average_quantity
+FROM books
+GROUP BY genre;
@@ -0,0 +1,11 @@
+-- selectWithActivePolicy
+-- $1 Reference time
+-- $2 Cursor
+-- $3 Limit
+SELECT b.id, b.isbn, b.title, b.author, b.genre, b.quantity, b.publicized_at,
+       pp.id, pp.book_id, pp.price, pp.start_date, pp.end_date
+FROM books b
+JOIN price_policies pp ON pp.book_id = b.id AND pp.start_date <= $1 AND pp.end_date > $1
+WHERE b.id > $2
+ORDER BY b.id
+LIMIT $3;
@@ -0,0 +1,4 @@
+-- selectJSONPaginating
+-- $1 Cursor
+-- $2 Limit
+SELECT * FROM json_books WHERE id > $1 ORDER BY id LIMIT $2;
@@ -0,0 +1,4 @@
+-- selectNullablePaginating
+-- $1 Cursor
+-- $2 Limit
+SELECT * FROM nullable_books WHERE id > $1 ORDER BY id LIMIT $2;
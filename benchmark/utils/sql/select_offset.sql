@@ -0,0 +1,4 @@
+-- selectOffset
+-- $1 Offset
+-- $2 Limit
+SELECT * FROM books ORDER BY id OFFSET $1 LIMIT $2;
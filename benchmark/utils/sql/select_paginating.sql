@@ -1,4 +1,4 @@
 -- selectPaginating
 -- $1 Cursor
 -- $2 Limit
-SELECT * FROM books WHERE id > $1 LIMIT $2;
+SELECT * FROM books WHERE id > $1 ORDER BY id LIMIT $2;
@@ -0,0 +1,3 @@
+-- selectPoliciesByBook
+-- $1 Book ID
+SELECT id, book_id, price, start_date, end_date FROM price_policies WHERE book_id = $1 ORDER BY id;
@@ -0,0 +1,3 @@
+-- selectPoliciesByBooks
+-- $1 Book IDs
+SELECT id, book_id, price, start_date, end_date FROM price_policies WHERE book_id = ANY($1) ORDER BY book_id, id;
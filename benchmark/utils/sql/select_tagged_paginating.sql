@@ -0,0 +1,4 @@
+-- selectTaggedPaginating
+-- $1 Cursor
+-- $2 Limit
+SELECT * FROM tagged_books WHERE id > $1 ORDER BY id LIMIT $2;
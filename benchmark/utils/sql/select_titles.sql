@@ -0,0 +1,4 @@
+-- selectTitles
+-- $1 Cursor
+-- $2 Limit
+SELECT id, title FROM books WHERE id > $1 ORDER BY id LIMIT $2;
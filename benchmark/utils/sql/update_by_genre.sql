@@ -0,0 +1,4 @@
+-- updateBooksByGenre
+-- $1 Quantity
+-- $2 Genre
+UPDATE books SET quantity = $1 WHERE genre = $2;
@@ -0,0 +1,22 @@
+-- updateNullableBook
+-- $1 ISBN
+-- $2 Title
+-- $3 Subtitle, or NULL
+-- $4 Description, or NULL
+-- $5 Author
+-- $6 Genre
+-- $7 Quantity
+-- $8 Publishing date
+-- $9 Discontinuation date, or NULL
+-- $10 ID
+UPDATE nullable_books
+SET isbn = $1,
+    title = $2,
+    subtitle = $3,
+    description = $4,
+    author = $5,
+    genre = $6,
+    quantity = $7,
+    publicized_at = $8,
+    discontinued_at = $9
+WHERE id = $10;
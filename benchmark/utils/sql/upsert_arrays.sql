@@ -0,0 +1,11 @@
+-- upsertBookArrays
+-- $1 ISBNs
+-- $2 Titles
+-- $3 Authors
+-- $4 Genres
+-- $5 Quantities
+-- $6 Publishing dates
+INSERT INTO books (isbn, title, author, genre, quantity, publicized_at)
+SELECT unnest($1::varchar[]), unnest($2::varchar[]), unnest($3::varchar[]), unnest($4::varchar[]),
+       unnest($5::int[]), unnest($6::timestamp[])
+ON CONFLICT (isbn) DO UPDATE SET quantity = EXCLUDED.quantity;
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/compare"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/export"
+)
+
+const (
+	compareCommand    = "compare"
+	defaultResultsDir = "results"
+)
+
+// thresholdFlags registers the regression thresholds, in percent, into the flag set.
+func thresholdFlags(fs *flag.FlagSet) func() compare.Thresholds {
+	ns := fs.Float64("max-ns-regression", 10, "Specify the accepted ns/op increase over the baseline, in percent")
+	bytes := fs.Float64("max-bytes-regression", 20, "Specify the accepted B/op increase over the baseline, in percent")
+	allocs := fs.Float64("max-allocs-regression", 20, "Specify the accepted allocs/op increase over the baseline, in percent")
+	return func() compare.Thresholds {
+		return compare.Thresholds{
+			compare.NsPerOp:     *ns / 100,
+			compare.BytesPerOp:  *bytes / 100,
+			compare.AllocsPerOp: *allocs / 100,
+		}
+	}
+}
+
+// runCompare implements the compare command, which diffs exported JSON results against a
+// saved baseline without running any benchmark.
+func runCompare(args []string) {
+	fs := flag.NewFlagSet(compareCommand, flag.ExitOnError)
+	baseline := fs.String("baseline", "", "Specify the baseline name, or the path of a JSON file")
+	resultsPath := fs.String("results", "", "Specify the JSON results to compare, exported with -format json")
+	resultsDir := fs.String("results-dir", defaultResultsDir, "Specify the directory holding the baselines")
+	thresholds := thresholdFlags(fs)
+	_ = fs.Parse(args)
+
+	if *baseline == "" || *resultsPath == "" {
+		log.Fatal("compare requires both -baseline and -results")
+	}
+
+	baselineResults, err := compare.LoadBaseline(*resultsDir, *baseline)
+	if err != nil {
+		log.Fatal("could not load the baseline: ", err)
+	}
+	doc, err := export.ReadDocument(*resultsPath)
+	if err != nil {
+		log.Fatal("could not read the results: ", err)
+	}
+	if !printComparison(os.Stdout, *baseline, baselineResults, doc.Wrappers(), thresholds()) {
+		os.Exit(1)
+	}
+}
+
+// printComparison prints the comparison of the results against the baseline and
+// returns false when any metric regressed beyond its threshold.
+func printComparison(w io.Writer, name string, baseline, results []benchmark.ResultWrapper, thresholds compare.Thresholds) bool {
+	deltas := compare.Compare(baseline, results, thresholds)
+	compare.Write(w, deltas)
+	if compare.HasRegression(deltas) {
+		log.Printf("the results regressed against the baseline %q", name)
+		return false
+	}
+	return true
+}
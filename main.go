@@ -1,24 +1,37 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"math/rand"
 	"os"
+	"path"
+	"regexp"
 	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"text/tabwriter"
 	"time"
 
 	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/compare"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/environment"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/export"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/histogram"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
 
 	// Auto load .env file.
 	_ "github.com/joho/godotenv/autoload"
 )
 
 const (
-	all = "all"
+	all        = "all"
+	textFormat = "text"
 
 	insertOp     = "insert"
 	insertBulkOp = "insert-bulk"
@@ -26,6 +39,34 @@ const (
 	deleteOp     = "delete"
 	selectOne    = "select-one"
 	selectPage   = "select-page"
+	selectJoin   = "select-join"
+	// selectEager is followed by the load strategy, e.g. select-eager/preload.
+	selectEager = "select-eager"
+
+	transactionCommit   = "transaction/commit"
+	transactionRollback = "transaction/rollback"
+	upsertOp            = "upsert"
+	// updateBulkOp and deleteBulkOp are followed by the number of affected books, e.g. update-bulk/100.
+	updateBulkOp = "update-bulk"
+	deleteBulkOp = "delete-bulk"
+	// selectManyIDs is followed by the way of passing the IDs, e.g. select-many-ids/any.
+	selectManyIDs    = "select-many-ids"
+	aggregateOp      = "aggregate"
+	searchOp         = "search"
+	selectProjection = "select-projection"
+	// scanLarge is followed by the scan mode, e.g. scan-large/streaming.
+	scanLarge = "scan-large"
+	// insertNullable and the following ones run on the schema variant with nullable columns.
+	insertNullable = "insert-nullable"
+	selectNullable = "select-nullable"
+	updateNullable = "update-nullable"
+	// insertJSON and selectJSON are followed by the size of the documents, e.g. insert-json/large.
+	insertJSON = "insert-json"
+	selectJSON = "select-json"
+	// insertTags and the following ones run on the schema variant with a TEXT[] tags column.
+	insertTags = "insert-tags"
+	selectTags = "select-tags"
+	filterTags = "filter-tags"
 
 	raw  = "raw"
 	pgx  = "pgx"
@@ -37,66 +78,395 @@ const (
 
 var (
 	benchmarksMap   = map[string]benchmark.Benchmark{}
-	validOperations = []string{insertOp, insertBulkOp, updateOp, deleteOp, selectOne, selectPage}
+	validOperations = slices.Concat(
+		[]string{insertOp, insertBulkOp, updateOp, deleteOp, selectOne, selectPage, selectJoin},
+		eagerOperations(),
+		[]string{transactionCommit, transactionRollback, upsertOp},
+		bulkOperations(),
+		manyIDsOperations(),
+		[]string{aggregateOp, searchOp, selectProjection},
+		pageAtOperations(),
+		scanLargeOperations(),
+		[]string{insertNullable, selectNullable, updateNullable},
+		jsonOperations(),
+		[]string{insertTags, selectTags, filterTags},
+	)
+	validOrms = []string{raw, pgx, bun, gorm, ent, sqlc}
 )
 
 func main() {
-	operation := flag.String("operation", selectOne, "Specify the operation to run")
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case compareCommand:
+			runCompare(os.Args[2:])
+			return
+		case reportCommand:
+			runReport(os.Args[2:])
+			return
+		}
+	}
+
+	defaults := utils.DefaultConfig()
+	configPath := flag.String("config", "", "Specify a YAML config file, overridden by environment variables and flags")
+	_ = flag.String(utils.OperationKey, selectOne, "Specify the operations to run, comma-separated. Names, globs, regular expressions and all are accepted")
+	_ = flag.String(utils.OrmKey, all, "Specify the ORMs to run, comma-separated. Names, globs, regular expressions and all are accepted")
+	_ = flag.Int(utils.PoolSizeKey, defaults.PoolSize, "Specify the maximum number of connections of each ORM, zero meaning one per goroutine")
+	_ = flag.Int(utils.ParallelismKey, defaults.Parallelism, "Specify the number of goroutines running each operation concurrently")
+	_ = flag.Int(utils.BulkInsertNumberKey, defaults.BulkInsertNumber, "Specify the number of books inserted by each insert-bulk operation")
+	_ = flag.Int(utils.BatchSizeKey, defaults.BatchSize, "Specify the number of books inserted per statement when seeding the database")
+	_ = flag.Int(utils.PageSizeKey, defaults.PageSize, "Specify the number of books fetched by each select-page operation")
+	_ = flag.Int(utils.IDsNumberKey, defaults.IDsNumber, "Specify the number of books fetched by each select-many-ids operation")
+	_ = flag.String(utils.BenchtimeKey, defaults.Benchtime, "Specify the run time of each benchmark, as a duration or as Nx iterations")
+	_ = flag.Int(utils.SmallDocumentReviewsKey, defaults.SmallDocumentReviews, "Specify the number of reviews in the small JSONB metadata documents")
+	_ = flag.Int(utils.MediumDocumentReviewsKey, defaults.MediumDocumentReviews, "Specify the number of reviews in the medium JSONB metadata documents")
+	_ = flag.Int(utils.LargeDocumentReviewsKey, defaults.LargeDocumentReviews, "Specify the number of reviews in the large JSONB metadata documents")
+	_ = flag.String(utils.PrimaryKeyKey, defaults.PrimaryKey, "Specify the primary key of books and price policies: "+strings.Join(utils.PrimaryKeys, ", "))
+	histogramsPath := flag.String("histograms", "", "Specify a file to write the latency histograms to, as JSON")
+	format := flag.String("format", textFormat, "Specify the output format: text, "+strings.Join(export.Formats, ", "))
+	out := flag.String("out", "", "Specify a file to write the output to instead of stdout")
+	_ = flag.Int(utils.CountKey, defaults.Count, "Specify how many times each ORM and operation pair runs")
+	resultsDir := flag.String("results-dir", defaultResultsDir, "Specify the directory holding the baselines")
+	saveBaseline := flag.String("save-baseline", "", "Specify a name to save the results as a baseline")
+	baseline := flag.String("baseline", "", "Specify a baseline to compare the results against")
+	sweep := flag.String("sweep", "", "Specify a parameter to vary, as name=v1,v2,... or name=from:to:step with step +N or xN: "+strings.Join(sweepParameters, ", "))
+	thresholds := thresholdFlags(flag.CommandLine)
 	flag.Parse()
 
-	if operation == nil && *operation != all && slices.Contains(validOperations, *operation) {
-		log.Fatal("define a valid orm or operation")
+	if utils.PostgresDSN == "" {
+		log.Fatal("POSTGRES_DSN is required")
+	}
+	if *format != textFormat && !slices.Contains(export.Formats, *format) {
+		log.Fatalf("unknown format %q, valid ones are: text, %s", *format, strings.Join(export.Formats, ", "))
+	}
+
+	config, err := loadConfig(*configPath)
+	if err != nil {
+		log.Fatal("invalid configuration: ", err)
+	}
+	operations, err := matchNames(config.Operations, validOperations)
+	if err != nil {
+		log.Fatal("invalid operation: ", err)
+	}
+	orms, err := matchNames(config.Orms, validOrms)
+	if err != nil {
+		log.Fatal("invalid orm: ", err)
+	}
+
+	var sweepParameter string
+	var sweepValues []int
+	if *sweep != "" {
+		if *baseline != "" || *saveBaseline != "" || *histogramsPath != "" {
+			log.Fatal("-sweep can't be combined with -baseline, -save-baseline or -histograms")
+		}
+		sweepParameter, sweepValues, err = parseSweep(*sweep)
+		if err != nil {
+			log.Fatal("invalid sweep: ", err)
+		}
+	}
+
+	var baselineResults []benchmark.ResultWrapper
+	if *baseline != "" {
+		baselineResults, err = compare.LoadBaseline(*resultsDir, *baseline)
+		if err != nil {
+			log.Fatal("could not load the baseline: ", err)
+		}
+	}
+
+	// The output file is created upfront so a bad path doesn't throw a whole execution away.
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			log.Fatal("could not create the output file: ", err)
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+		w = file
+	}
+
+	env := environment.Capture(context.Background())
+	loadBenchmarks(orms)
+
+	if *sweep != "" {
+		points, err := runSweep(config, operations, sweepParameter, sweepValues)
+		if err != nil {
+			log.Fatal("invalid sweep: ", err)
+		}
+		if *format == textFormat {
+			printSweep(w, config, env, sweepParameter, points)
+		} else if err := export.WriteSweep(w, *format, sweepParameter, env, points); err != nil {
+			log.Fatal("could not export the results: ", err)
+		}
+		return
 	}
 
-	loadBenchmarks()
-	shuffleBenchmarksMap()
-	results := executeBenchmarks(*operation)
-	printBenchmark(results, *operation)
+	results := executeBenchmarks(operations, config.Count)
+
+	if *format == textFormat {
+		printBenchmark(w, config, env, results, operations)
+	} else if err := export.Write(w, *format, config, env, results); err != nil {
+		log.Fatal("could not export the results: ", err)
+	}
+
+	if *histogramsPath != "" {
+		if err := writeHistograms(results, *histogramsPath); err != nil {
+			log.Fatal("could not write the latency histograms: ", err)
+		}
+	}
+
+	if *saveBaseline != "" {
+		if err := compare.SaveBaseline(*resultsDir, *saveBaseline, config, env, results); err != nil {
+			log.Fatal("could not save the baseline: ", err)
+		}
+	}
+
+	if *baseline != "" {
+		// Keep machine-readable output on stdout parseable.
+		var comparisonWriter io.Writer = os.Stdout
+		if *format != textFormat && *out == "" {
+			comparisonWriter = os.Stderr
+		}
+		if !printComparison(comparisonWriter, *baseline, baselineResults, results, thresholds()) {
+			os.Exit(1)
+		}
+	}
+}
+
+// loadConfig resolves the configuration from the defaults, the optional config file,
+// the environment variables and the flags, in increasing order of precedence.
+func loadConfig(path string) (utils.Config, error) {
+	config := utils.DefaultConfig()
+	if path != "" {
+		if err := config.LoadFile(path); err != nil {
+			return config, err
+		}
+	}
+	if err := config.LoadEnv(); err != nil {
+		return config, err
+	}
+	if err := config.LoadFlags(flag.CommandLine); err != nil {
+		return config, err
+	}
+	if err := config.Validate(); err != nil {
+		return config, err
+	}
+	return config, config.Apply()
 }
 
-func loadBenchmarks() {
-	benchmarksMap[raw] = benchmark.NewRawBenchmark()
-	benchmarksMap[pgx] = benchmark.NewPgxBenchmark()
-	benchmarksMap[bun] = benchmark.NewBunBenchmark()
-	benchmarksMap[gorm] = benchmark.NewGormBenchmark()
-	benchmarksMap[ent] = benchmark.NewEntBenchmark()
-	benchmarksMap[sqlc] = benchmark.NewSqlcBenchmark()
+// matchNames resolves the patterns into the valid names they select, in the order of valid.
+// A pattern is either all, an exact name, a glob or, like go test -bench, a regular expression.
+// Patterns selecting nothing are rejected.
+func matchNames(patterns, valid []string) ([]string, error) {
+	selected := make(map[string]bool, len(valid))
+	for _, pattern := range patterns {
+		matched := matchName(pattern, valid)
+		if len(matched) == 0 {
+			return nil, fmt.Errorf("%q matches nothing, valid ones are: %s, %s", pattern, all, strings.Join(valid, ", "))
+		}
+		for _, name := range matched {
+			selected[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(selected))
+	for _, name := range valid {
+		if selected[name] {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func matchName(pattern string, valid []string) []string {
+	if pattern == all {
+		return valid
+	}
+	if slices.Contains(valid, pattern) {
+		return []string{pattern}
+	}
+
+	var matched []string
+	for _, name := range valid {
+		if ok, err := path.Match(pattern, name); err == nil && ok {
+			matched = append(matched, name)
+		}
+	}
+	if len(matched) > 0 {
+		return matched
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil
+	}
+	for _, name := range valid {
+		if re.MatchString(name) {
+			matched = append(matched, name)
+		}
+	}
+	return matched
+}
+
+func loadBenchmarks(orms []string) {
+	constructors := map[string]func() benchmark.Benchmark{
+		raw:  benchmark.NewRawBenchmark,
+		pgx:  benchmark.NewPgxBenchmark,
+		bun:  benchmark.NewBunBenchmark,
+		gorm: benchmark.NewGormBenchmark,
+		ent:  benchmark.NewEntBenchmark,
+		sqlc: benchmark.NewSqlcBenchmark,
+	}
+	for _, orm := range orms {
+		benchmarksMap[orm] = constructors[orm]()
+	}
+}
+
+// execution is a single benchmark run of an ORM operation.
+type execution struct {
+	orm       string
+	operation string
+}
+
+// shuffleExecutions interleaves every ORM and operation pair in a random order, so that
+// noise from the machine or the database is spread over all of them instead of a single one.
+func shuffleExecutions(rng *rand.Rand, operations []string) []execution {
+	executions := make([]execution, 0, len(benchmarksMap)*len(operations))
+	for orm := range benchmarksMap {
+		for _, op := range operations {
+			executions = append(executions, execution{orm: orm, operation: op})
+		}
+	}
+	rng.Shuffle(len(executions), func(i, j int) {
+		executions[i], executions[j] = executions[j], executions[i]
+	})
+	return executions
 }
 
-func shuffleBenchmarksMap() {
+func executeBenchmarks(operations []string, count int) []benchmark.ResultWrapper {
+	wrappers := make(map[string]*benchmark.ResultWrapper, len(benchmarksMap))
+	for orm, b := range benchmarksMap {
+		wrappers[orm] = &benchmark.ResultWrapper{
+			Orm:        orm,
+			Benchmarks: make(map[string]testing.BenchmarkResult),
+			Samples:    make(map[string][]testing.BenchmarkResult),
+			Latencies:  make(map[string]*histogram.Histogram),
+			Err:        b.Init(),
+		}
+	}
+
 	source := rand.NewSource(time.Now().UnixNano())
 	rng := rand.New(source)
-	keys := make([]string, 0, len(benchmarksMap))
-	for key := range benchmarksMap {
-		keys = append(keys, key)
+	for i := 0; i < count; i++ {
+		for _, e := range shuffleExecutions(rng, operations) {
+			wrapper := wrappers[e.orm]
+			if wrapper.Err != nil {
+				continue
+			}
+			doExecuteBenchmark(benchmarksMap[e.orm], wrapper, e.operation)
+		}
 	}
-	rng.Shuffle(len(keys), func(i, j int) {
-		keys[i], keys[j] = keys[j], keys[i]
-	})
-	shuffledMap := make(map[string]benchmark.Benchmark)
-	for _, key := range keys {
-		shuffledMap[key] = benchmarksMap[key]
+
+	results := make([]benchmark.ResultWrapper, 0, len(wrappers))
+	for orm, wrapper := range wrappers {
+		for op, samples := range wrapper.Samples {
+			wrapper.Benchmarks[op] = benchmark.MedianRun(samples)
+		}
+		if wrapper.Err == nil {
+			_ = benchmarksMap[orm].Close()
+		}
+		results = append(results, *wrapper)
 	}
-	benchmarksMap = shuffledMap
+	return results
 }
 
-func executeBenchmarks(operation string) []benchmark.ResultWrapper {
-	var results []benchmark.ResultWrapper
-	for ormName, b := range benchmarksMap {
-		results = append(results, doExecuteBenchmarks(b, ormName, operation))
+// eagerOperations returns the variants of the select-eager operation, one per load strategy.
+func eagerOperations() []string {
+	operations := make([]string, len(benchmark.LoadStrategies))
+	for i, strategy := range benchmark.LoadStrategies {
+		operations[i] = eagerOperation(strategy)
 	}
-	return results
+	return operations
 }
 
-func doExecuteBenchmarks(b benchmark.Benchmark, orm, operation string) benchmark.ResultWrapper {
-	benchmark.BeforeBenchmark()
-	wrapper := benchmark.ResultWrapper{}
-	wrapper.Orm = orm
-	err := b.Init()
-	if err != nil {
-		wrapper.Err = err
+func eagerOperation(strategy benchmark.LoadStrategy) string {
+	return selectEager + "/" + string(strategy)
+}
+
+// bulkOperations returns the variants of the update-bulk and delete-bulk operations, one per number
+// of affected books.
+func bulkOperations() []string {
+	var operations []string
+	for _, operation := range []string{updateBulkOp, deleteBulkOp} {
+		for _, affected := range benchmark.AffectedSizes {
+			operations = append(operations, bulkOperation(operation, affected))
+		}
+	}
+	return operations
+}
+
+func bulkOperation(operation string, affected int) string {
+	return operation + "/" + strconv.Itoa(affected)
+}
+
+// manyIDsOperations returns the variants of the select-many-ids operation, one per way of passing the IDs.
+func manyIDsOperations() []string {
+	operations := make([]string, len(benchmark.IDLists))
+	for i, list := range benchmark.IDLists {
+		operations[i] = manyIDsOperation(list)
+	}
+	return operations
+}
+
+func manyIDsOperation(list benchmark.IDList) string {
+	return selectManyIDs + "/" + string(list)
+}
+
+// pageAtOperations returns the variants of the deep pagination operations, one per pagination and depth.
+func pageAtOperations() []string {
+	var operations []string
+	for _, pagination := range benchmark.Paginations {
+		for _, depth := range benchmark.PageDepths {
+			operations = append(operations, pageAtOperation(pagination, depth))
+		}
+	}
+	return operations
+}
+
+// jsonOperations returns the variants of the JSONB operations, one per document size.
+func jsonOperations() []string {
+	var operations []string
+	for _, op := range []string{insertJSON, selectJSON} {
+		for _, size := range benchmark.DocumentSizes {
+			operations = append(operations, jsonOperation(op, size))
+		}
+	}
+	return operations
+}
+
+func jsonOperation(op string, size benchmark.DocumentSize) string {
+	return op + "/" + string(size)
+}
+
+// scanLargeOperations returns the variants of the scan-large operation, one per scan mode.
+func scanLargeOperations() []string {
+	operations := make([]string, len(benchmark.ScanModes))
+	for i, mode := range benchmark.ScanModes {
+		operations[i] = scanLargeOperation(mode)
 	}
-	resultMap := make(map[string]testing.BenchmarkResult)
+	return operations
+}
+
+func scanLargeOperation(mode benchmark.ScanMode) string {
+	return scanLarge + "/" + string(mode)
+}
+
+// pageAtOperation names the variant after the pagination and the depth, e.g. select-page-offset/10000.
+func pageAtOperation(pagination benchmark.Pagination, depth int) string {
+	return selectPage + "-" + string(pagination) + "/" + strconv.Itoa(depth)
+}
+
+func doExecuteBenchmark(b benchmark.Benchmark, wrapper *benchmark.ResultWrapper, operation string) {
 	operations := map[string]func(*testing.B){
 		insertOp:     b.Insert,
 		insertBulkOp: b.InsertBulk,
@@ -104,49 +474,163 @@ func doExecuteBenchmarks(b benchmark.Benchmark, orm, operation string) benchmark
 		deleteOp:     b.Delete,
 		selectOne:    b.FindByID,
 		selectPage:   b.FindPage,
+		selectJoin:   b.FindWithActivePolicy,
+
+		transactionCommit:   b.Transaction,
+		transactionRollback: b.TransactionRollback,
+		upsertOp:            b.Upsert,
+		aggregateOp:         b.Aggregate,
+		searchOp:            b.Search,
+		selectProjection:    b.FindTitles,
+		insertNullable:      b.InsertNullable,
+		selectNullable:      b.FindNullable,
+		updateNullable:      b.UpdateNullable,
+		insertTags:          b.InsertTags,
+		selectTags:          b.FindTags,
+		filterTags:          b.FilterTags,
+	}
+	for _, strategy := range benchmark.LoadStrategies {
+		operations[eagerOperation(strategy)] = b.FindPageWithPolicies(strategy)
+	}
+	for _, affected := range benchmark.AffectedSizes {
+		operations[bulkOperation(updateBulkOp, affected)] = b.UpdateBulk(affected)
+		operations[bulkOperation(deleteBulkOp, affected)] = b.DeleteBulk(affected)
+	}
+	for _, list := range benchmark.IDLists {
+		operations[manyIDsOperation(list)] = b.FindByIDs(list)
 	}
-	if operation == all {
-		for op, f := range operations {
-			resultMap[op] = testing.Benchmark(f)
+	for _, pagination := range benchmark.Paginations {
+		for _, depth := range benchmark.PageDepths {
+			operations[pageAtOperation(pagination, depth)] = b.FindPageAt(pagination, depth)
 		}
-		wrapper.Benchmarks = resultMap
-		return wrapper
 	}
-	wrapper.Benchmarks = map[string]testing.BenchmarkResult{
-		operation: testing.Benchmark(operations[operation]),
+	for _, mode := range benchmark.ScanModes {
+		operations[scanLargeOperation(mode)] = b.ScanLarge(mode)
+	}
+	for _, size := range benchmark.DocumentSizes {
+		operations[jsonOperation(insertJSON, size)] = b.InsertJSON(size)
+		operations[jsonOperation(selectJSON, size)] = b.FindJSON(size)
+	}
+	// Variants a library doesn't support are left out of its results.
+	if operations[operation] == nil {
+		return
+	}
+
+	benchmark.BeforeBenchmark()
+	result, latencies := benchmark.Measure(operations[operation])
+	wrapper.Samples[operation] = append(wrapper.Samples[operation], result)
+	if merged, ok := wrapper.Latencies[operation]; ok {
+		merged.Merge(latencies)
+	} else {
+		wrapper.Latencies[operation] = latencies
 	}
-	return wrapper
 }
 
-func printBenchmark(results []benchmark.ResultWrapper, operation string) {
+func printBenchmark(w io.Writer, config utils.Config, env environment.Environment, results []benchmark.ResultWrapper, operations []string) {
 	table := new(tabwriter.Writer)
-	table.Init(os.Stdout, 0, 8, 2, '\t', tabwriter.AlignRight)
-	if operation == all {
-		doPrintBenchmark(table, results, validOperations...)
-	} else {
-		doPrintBenchmark(table, results, operation)
+	table.Init(w, 0, 8, 2, '\t', tabwriter.AlignRight)
+	_, _ = fmt.Fprintf(table, "Environment: %s\n", formatPairs(env.Pairs()))
+	_, _ = fmt.Fprintf(table, "Config: %s\n", formatPairs(config.Pairs()))
+	doPrintBenchmark(table, results, operations...)
+}
+
+// formatPairs joins the pairs as space-separated key=value items. Values with spaces are quoted.
+func formatPairs(pairs [][2]string) string {
+	items := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		value := pair[1]
+		if strings.Contains(value, " ") {
+			value = strconv.Quote(value)
+		}
+		items = append(items, pair[0]+"="+value)
 	}
+	return strings.Join(items, " ")
 }
 
 func doPrintBenchmark(table *tabwriter.Writer, results []benchmark.ResultWrapper, operations ...string) {
 	for _, op := range operations {
+		fastest := fastestResult(results, op)
+		runs := len(fastest.Samples[op])
+
 		_, _ = fmt.Fprint(table, "\n")
-		_, _ = fmt.Fprintf(table, "Operation: %s\n", op)
+		_, _ = fmt.Fprintf(table, "Operation: %s (parallelism: %d, runs: %d)\n", op, utils.Parallelism, runs)
 
 		for _, r := range results {
 			result, ok := r.Benchmarks[op]
 			if !ok {
 				continue
 			}
-			_, _ = fmt.Fprintf(table, "%s:\t%d\t%d ns/op\t%d B/op\t%d allocs/op\n",
+			_, _ = fmt.Fprintf(table, "%s:\t%d\t%d ns/op\t%d B/op\t%d allocs/op\t%.0f ops/s\t%.0f latency-ns/op"+
+				"\t%s p50\t%s p90\t%s p99\t%s p99.9\t%s max",
 				r.Orm,
 				result.N,
 				result.NsPerOp(),
 				result.AllocedBytesPerOp(),
 				result.AllocsPerOp(),
+				result.Extra[benchmark.OpsPerSecMetric],
+				result.Extra[benchmark.LatencyMetric],
+				time.Duration(result.Extra[benchmark.P50Metric]),
+				time.Duration(result.Extra[benchmark.P90Metric]),
+				time.Duration(result.Extra[benchmark.P99Metric]),
+				time.Duration(result.Extra[benchmark.P999Metric]),
+				time.Duration(result.Extra[benchmark.MaxMetric]),
 			)
+			if peak, ok := result.Extra[benchmark.PeakHeapMetric]; ok {
+				_, _ = fmt.Fprintf(table, "\t%.0f peak-heap-B", peak)
+			}
+			if runs > 1 {
+				summary := r.Summary(op)
+				_, _ = fmt.Fprintf(table, "\tmean %.0f ns/op ±%.1f%%\tmedian %.0f ns/op\tstddev %.0f ns/op\tcv %.1f%%\t%s",
+					summary.Mean,
+					summary.CIMargin()*100,
+					summary.Median,
+					summary.Stddev,
+					summary.CV*100,
+					significance(r, fastest, op),
+				)
+			}
+			_, _ = fmt.Fprint(table, "\n")
 		}
 
 		_ = table.Flush()
 	}
 }
+
+// fastestResult returns the result with the lowest mean ns/op for the operation.
+func fastestResult(results []benchmark.ResultWrapper, operation string) benchmark.ResultWrapper {
+	var fastest benchmark.ResultWrapper
+	for _, r := range results {
+		if _, ok := r.Benchmarks[operation]; !ok {
+			continue
+		}
+		if fastest.Orm == "" || r.Summary(operation).Mean < fastest.Summary(operation).Mean {
+			fastest = r
+		}
+	}
+	return fastest
+}
+
+// significance tells whether the result is distinguishable from the fastest one, by
+// checking whether their 95% confidence intervals overlap.
+func significance(r, fastest benchmark.ResultWrapper, operation string) string {
+	if r.Orm == fastest.Orm {
+		return "fastest"
+	}
+	if r.Summary(operation).Overlaps(fastest.Summary(operation)) {
+		return "no significant difference vs " + fastest.Orm
+	}
+	return fmt.Sprintf("+%.1f%% vs %s", (r.Summary(operation).Mean/fastest.Summary(operation).Mean-1)*100, fastest.Orm)
+}
+
+// writeHistograms stores the latency histograms as JSON, keyed by ORM and then by operation.
+func writeHistograms(results []benchmark.ResultWrapper, path string) error {
+	histograms := make(map[string]map[string]*histogram.Histogram, len(results))
+	for _, r := range results {
+		histograms[r.Orm] = r.Latencies
+	}
+	data, err := json.MarshalIndent(histograms, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMatchNames(t *testing.T) {
+	valid := []string{"insert", "insert-bulk", "update", "delete", "select-one", "select-page", "select-page-offset"}
+
+	tests := []struct {
+		name     string
+		patterns []string
+		want     []string
+		wantErr  bool
+	}{
+		{name: "all", patterns: []string{"all"}, want: valid},
+		{name: "exact", patterns: []string{"update"}, want: []string{"update"}},
+		{name: "exact not expanded as regex", patterns: []string{"insert"}, want: []string{"insert"}},
+		{name: "list in valid order", patterns: []string{"delete", "insert"}, want: []string{"insert", "delete"}},
+		{name: "duplicates", patterns: []string{"update", "update"}, want: []string{"update"}},
+		{name: "glob", patterns: []string{"select-*"}, want: []string{"select-one", "select-page", "select-page-offset"}},
+		{name: "glob character class", patterns: []string{"[du]*"}, want: []string{"update", "delete"}},
+		{name: "regex", patterns: []string{"^select-page"}, want: []string{"select-page", "select-page-offset"}},
+		{name: "regex unanchored", patterns: []string{"bulk|offset"}, want: []string{"insert-bulk", "select-page-offset"}},
+		{name: "glob and regex", patterns: []string{"insert*", "one$"}, want: []string{"insert", "insert-bulk", "select-one"}},
+		{name: "unknown", patterns: []string{"upsert"}, wantErr: true},
+		{name: "invalid regex", patterns: []string{"("}, wantErr: true},
+		{name: "one pattern matching nothing", patterns: []string{"update", "nothing*"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchNames(tt.patterns, valid)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("matchNames(%q) = %v, want an error", tt.patterns, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("matchNames(%q) failed: %v", tt.patterns, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("matchNames(%q) = %v, want %v", tt.patterns, got, tt.want)
+			}
+		})
+	}
+}
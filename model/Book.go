@@ -1,39 +1,105 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Key is the type of the primary keys of books, price policies and the book variants: int64 in the
+// SERIAL schema and uuid.UUID in the UUIDv7 one.
+type Key interface {
+	int64 | uuid.UUID
+}
 
 // Book represents a book from a bookstore system.
-type Book struct {
-	ID           int64 `bun:"id,pk,autoincrement" gorm:"primary_key"`
+type Book[K Key] struct {
+	// BaseModel names the table for Bun, which would otherwise derive it from the instantiated type.
+	bun.BaseModel `bun:"table:books,alias:book"`
+	// The default tells GORM the database generates the key, as it only assumes so for integers.
+	ID           K `bun:"id,pk,autoincrement" gorm:"primary_key;default:(-)"`
 	ISBN         string
 	Title        string
 	Author       string
 	Genre        string
 	Quantity     int
 	PublicizedAt time.Time
+	// ActivePolicy is the price policy in effect, loaded by joins.
+	ActivePolicy *PricePolicy[K] `bun:"rel:has-one,join:id=book_id" gorm:"foreignKey:BookID"`
+	// Policies holds every price policy of the book, loaded eagerly.
+	Policies []*PricePolicy[K] `bun:"rel:has-many,join:id=book_id" gorm:"foreignKey:BookID"`
+}
+
+// TableName names the table for GORM, which would otherwise derive it from the instantiated type.
+func (Book[K]) TableName() string {
+	return "books"
+}
+
+// Genres are the genres NewBooksAcrossGenres spreads its books across, the first one being the
+// genre of NewBook.
+var Genres = []string{"Programming", "Fiction", "History", "Science", "Biography"}
+
+func NewBooks[K Key](quantity int) []*Book[K] {
+	books := make([]*Book[K], quantity)
+	for i := 0; i < quantity; i++ {
+		books[i] = NewBook[K]()
+	}
+	return books
 }
 
-func NewBooks(quantity int) []*Book {
-	books := make([]*Book, quantity)
+// NewBooksAcrossGenres returns quantity books of NewBook, cycling through Genres.
+func NewBooksAcrossGenres[K Key](quantity int) []*Book[K] {
+	books := make([]*Book[K], quantity)
 	for i := 0; i < quantity; i++ {
-		books[i] = NewBook()
+		books[i] = NewBookOfGenre[K](Genres[i%len(Genres)])
 	}
 	return books
 }
 
-func NewBook() *Book {
-	return &Book{
+func NewBook[K Key]() *Book[K] {
+	return NewBookOfGenre[K](Genres[0])
+}
+
+// NewBookOfGenre returns the book of NewBook with the given genre.
+func NewBookOfGenre[K Key](genre string) *Book[K] {
+	return &Book[K]{
 		ISBN:         "978-3-16-148410-1",
 		Title:        "Learning Go: An Idiomatic Approach to Real-World Go Programming",
 		Author:       "Jon Bodner",
-		Genre:        "Programming",
+		Genre:        genre,
 		Quantity:     20,
 		PublicizedAt: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
 	}
 }
 
-func Chunk(input []*Book, batchSize int) [][]*Book {
-	var result [][]*Book
+// NewUniqueBook returns a book whose ISBN no other book has, see NextISBN.
+func NewUniqueBook[K Key]() *Book[K] {
+	book := NewBook[K]()
+	book.ISBN = NextISBN()
+	return book
+}
+
+var isbnSequence atomic.Int64
+
+// NextISBN returns a valid ISBN-13 that no previous call returned, for tables where isbn is unique.
+func NextISBN() string {
+	digits := fmt.Sprintf("978%09d", isbnSequence.Add(1))
+	sum := 0
+	for i, digit := range digits {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(digit-'0') * weight
+	}
+	return fmt.Sprintf("%s-%s-%d", digits[:3], digits[3:], (10-sum%10)%10)
+}
+
+func Chunk[T any](input []T, batchSize int) [][]T {
+	var result [][]T
 	for i := 0; i < len(input); i += batchSize {
 		end := i + batchSize
 		if end > len(input) {
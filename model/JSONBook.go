@@ -0,0 +1,88 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// JSONBook is a book of the schema variant with a JSONB metadata column.
+type JSONBook[K Key] struct {
+	bun.BaseModel `bun:"table:json_books,alias:json_book"`
+	ID            K `bun:"id,pk,autoincrement" gorm:"primary_key;default:(-)"`
+	ISBN          string
+	Title         string
+	Author        string
+	Genre         string
+	Quantity      int
+	PublicizedAt  time.Time
+	Metadata      BookMetadata `bun:"type:jsonb" gorm:"serializer:json"`
+}
+
+// TableName names the table for GORM, see Book.TableName.
+func (JSONBook[K]) TableName() string {
+	return "json_books"
+}
+
+// BookMetadata is the document stored in the metadata column. Its size grows with the reviews.
+type BookMetadata struct {
+	Publisher string   `json:"publisher"`
+	Edition   int      `json:"edition"`
+	Language  string   `json:"language"`
+	Pages     int      `json:"pages"`
+	Keywords  []string `json:"keywords"`
+	Reviews   []Review `json:"reviews"`
+}
+
+// Review is a reader review of a book.
+type Review struct {
+	Reviewer  string    `json:"reviewer"`
+	Rating    int       `json:"rating"`
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewJSONBooks returns books of the JSONB variant whose metadata holds the given number of reviews.
+func NewJSONBooks[K Key](quantity, reviews int) []*JSONBook[K] {
+	books := make([]*JSONBook[K], quantity)
+	for i := range books {
+		books[i] = NewJSONBook[K](reviews)
+	}
+	return books
+}
+
+// NewJSONBook returns the book of NewBook along with metadata holding the given number of reviews.
+func NewJSONBook[K Key](reviews int) *JSONBook[K] {
+	book := NewBook[K]()
+	return &JSONBook[K]{
+		ISBN:         book.ISBN,
+		Title:        book.Title,
+		Author:       book.Author,
+		Genre:        book.Genre,
+		Quantity:     book.Quantity,
+		PublicizedAt: book.PublicizedAt,
+		Metadata:     NewBookMetadata(reviews),
+	}
+}
+
+// NewBookMetadata returns the metadata of NewBook with the given number of reviews.
+func NewBookMetadata(reviews int) BookMetadata {
+	metadata := BookMetadata{
+		Publisher: "O'Reilly Media",
+		Edition:   2,
+		Language:  "en",
+		Pages:     494,
+		Keywords:  []string{"go", "golang", "programming", "concurrency", "generics"},
+		Reviews:   make([]Review, reviews),
+	}
+	for i := range metadata.Reviews {
+		metadata.Reviews[i] = Review{
+			Reviewer:  fmt.Sprintf("Reader %d", i+1),
+			Rating:    i%5 + 1,
+			Comment:   "A thorough walk through idiomatic Go, from the basics of the language to its concurrency model and tooling.",
+			CreatedAt: time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, i),
+		}
+	}
+	return metadata
+}
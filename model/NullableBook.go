@@ -0,0 +1,57 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// NullableBook is a book of the schema variant with nullable columns. Subtitle and DiscontinuedAt
+// are pointers while Description is a sql.NullString, so both ways of mapping NULL are measured.
+type NullableBook[K Key] struct {
+	bun.BaseModel  `bun:"table:nullable_books,alias:nullable_book"`
+	ID             K `bun:"id,pk,autoincrement" gorm:"primary_key;default:(-)"`
+	ISBN           string
+	Title          string
+	Subtitle       *string
+	Description    sql.NullString
+	Author         string
+	Genre          string
+	Quantity       int
+	PublicizedAt   time.Time
+	DiscontinuedAt *time.Time
+}
+
+// TableName names the table for GORM, see Book.TableName.
+func (NullableBook[K]) TableName() string {
+	return "nullable_books"
+}
+
+// NewNullableBooks returns books of the nullable variant, every other one having NULL in all of
+// its nullable columns.
+func NewNullableBooks[K Key](quantity int) []*NullableBook[K] {
+	books := make([]*NullableBook[K], quantity)
+	for i := 0; i < quantity; i++ {
+		book := NewBook[K]()
+		books[i] = &NullableBook[K]{
+			ISBN:         book.ISBN,
+			Title:        book.Title,
+			Author:       book.Author,
+			Genre:        book.Genre,
+			Quantity:     book.Quantity,
+			PublicizedAt: book.PublicizedAt,
+		}
+		if i%2 == 0 {
+			subtitle := "An Idiomatic Approach to Real-World Go Programming"
+			discontinuedAt := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+			books[i].Subtitle = &subtitle
+			books[i].Description = sql.NullString{
+				String: "Go is rapidly becoming the preferred language for building web services.",
+				Valid:  true,
+			}
+			books[i].DiscontinuedAt = &discontinuedAt
+		}
+	}
+	return books
+}
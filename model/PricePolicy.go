@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// PricePolicy is the price of a book during a period of time.
+type PricePolicy[K Key] struct {
+	bun.BaseModel `bun:"table:price_policies,alias:price_policy"`
+	ID            K `bun:"id,pk,autoincrement" gorm:"primary_key;default:(-)"`
+	BookID        K
+	Price         float64
+	StartDate     time.Time
+	EndDate       time.Time
+}
+
+// TableName names the table for GORM, see Book.TableName.
+func (PricePolicy[K]) TableName() string {
+	return "price_policies"
+}
+
+// NewPricePolicies returns the policies of a book around now: an expired one, the active one
+// and a scheduled one.
+func NewPricePolicies[K Key](bookID K, now time.Time) []*PricePolicy[K] {
+	year := 365 * 24 * time.Hour
+	return []*PricePolicy[K]{
+		{BookID: bookID, Price: 39.99, StartDate: now.Add(-2 * year), EndDate: now.Add(-year)},
+		{BookID: bookID, Price: 34.99, StartDate: now.Add(-year), EndDate: now.Add(year)},
+		{BookID: bookID, Price: 44.99, StartDate: now.Add(year), EndDate: now.Add(2 * year)},
+	}
+}
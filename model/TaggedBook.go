@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/uptrace/bun"
+)
+
+// TaggedBook is a book of the schema variant with a TEXT[] tags column. Tags is a pq.StringArray
+// for GORM and database/sql, while Bun reads the array tag, pgx takes the underlying []string and
+// Ent converts it to a TextArray.
+type TaggedBook[K Key] struct {
+	bun.BaseModel `bun:"table:tagged_books,alias:tagged_book"`
+	ID            K `bun:"id,pk,autoincrement" gorm:"primary_key;default:(-)"`
+	ISBN          string
+	Title         string
+	Author        string
+	Genre         string
+	Quantity      int
+	PublicizedAt  time.Time
+	Tags          pq.StringArray `bun:",array" gorm:"type:text[]"`
+}
+
+// TableName names the table for GORM, see Book.TableName.
+func (TaggedBook[K]) TableName() string {
+	return "tagged_books"
+}
+
+// Tags are the tags NewTaggedBooks spreads across its books.
+var Tags = []string{"go", "databases", "concurrency", "web", "testing", "cloud"}
+
+// BookTags is the number of tags of every book returned by NewTaggedBooks.
+const BookTags = 3
+
+// NewTaggedBooks returns books of the TEXT[] variant, each one tagged with BookTags consecutive
+// Tags, starting at its position.
+func NewTaggedBooks[K Key](quantity int) []*TaggedBook[K] {
+	books := make([]*TaggedBook[K], quantity)
+	for i := range books {
+		book := NewBook[K]()
+		books[i] = &TaggedBook[K]{
+			ISBN:         book.ISBN,
+			Title:        book.Title,
+			Author:       book.Author,
+			Genre:        book.Genre,
+			Quantity:     book.Quantity,
+			PublicizedAt: book.PublicizedAt,
+			Tags:         make(pq.StringArray, BookTags),
+		}
+		for j := range books[i].Tags {
+			books[i].Tags[j] = Tags[(i+j)%len(Tags)]
+		}
+	}
+	return books
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TextArray is a TEXT[] value for database/sql, encoded and decoded with the pgtype codecs of pgx
+// instead of the parser of pq.StringArray. Ent maps the tags of the TEXT[] variant to it.
+type TextArray []string
+
+// textArrayMaps pools the pgtype maps, which cache their plans without locking.
+var textArrayMaps = sync.Pool{
+	New: func() any { return pgtype.NewMap() },
+}
+
+// Value encodes the array in the PostgreSQL text format.
+func (a TextArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	m := textArrayMaps.Get().(*pgtype.Map)
+	defer textArrayMaps.Put(m)
+
+	buf, err := m.Encode(pgtype.TextArrayOID, pgtype.TextFormatCode, []string(a), nil)
+	if err != nil {
+		return nil, err
+	}
+	return string(buf), nil
+}
+
+// Scan decodes an array in the PostgreSQL text format.
+func (a *TextArray) Scan(src any) error {
+	var data []byte
+	switch src := src.(type) {
+	case nil:
+		*a = nil
+		return nil
+	case string:
+		data = []byte(src)
+	case []byte:
+		data = src
+	default:
+		return fmt.Errorf("cannot scan %T into TextArray", src)
+	}
+	m := textArrayMaps.Get().(*pgtype.Map)
+	defer textArrayMaps.Put(m)
+
+	return m.Scan(pgtype.TextArrayOID, pgtype.TextFormatCode, data, (*[]string)(a))
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/export"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/report"
+)
+
+const reportCommand = "report"
+
+// runReport implements the report command, which renders exported JSON results as a
+// self-contained HTML page.
+func runReport(args []string) {
+	fs := flag.NewFlagSet(reportCommand, flag.ExitOnError)
+	resultsPath := fs.String("results", "", "Specify the JSON results to render, exported with -format json")
+	out := fs.String("out", "report.html", "Specify the HTML file to write")
+	title := fs.String("title", "Golang ORM Benchmarks", "Specify the title of the report")
+	_ = fs.Parse(args)
+
+	if *resultsPath == "" {
+		log.Fatal("report requires -results")
+	}
+
+	doc, err := export.ReadDocument(*resultsPath)
+	if err != nil {
+		log.Fatal("could not read the results: ", err)
+	}
+	file, err := os.Create(*out)
+	if err != nil {
+		log.Fatal("could not create the report: ", err)
+	}
+	if err = report.Write(file, *title, doc.Config, doc.Environment, doc.Wrappers()); err != nil {
+		_ = file.Close()
+		log.Fatal("could not render the report: ", err)
+	}
+	if err = file.Close(); err != nil {
+		log.Fatal("could not write the report: ", err)
+	}
+}
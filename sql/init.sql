@@ -1,5 +1,8 @@
 DROP TABLE IF EXISTS price_policies;
 DROP TABLE IF EXISTS books;
+DROP TABLE IF EXISTS nullable_books;
+DROP TABLE IF EXISTS json_books;
+DROP TABLE IF EXISTS tagged_books;
 
 CREATE TABLE IF NOT EXISTS books (
     id SERIAL PRIMARY KEY,
@@ -18,3 +21,40 @@ CREATE TABLE IF NOT EXISTS price_policies (
     start_date TIMESTAMP NOT NULL,
     end_date TIMESTAMP NOT NULL
 );
+
+CREATE TABLE IF NOT EXISTS nullable_books (
+    id SERIAL PRIMARY KEY,
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    subtitle VARCHAR(255),
+    description TEXT,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    discontinued_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS json_books (
+    id SERIAL PRIMARY KEY,
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    metadata JSONB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS tagged_books (
+    id SERIAL PRIMARY KEY,
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    tags TEXT[] NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS tagged_books_tags_idx ON tagged_books USING GIN (tags);
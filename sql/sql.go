@@ -5,4 +5,11 @@ import _ "embed"
 var (
 	//go:embed init.sql
 	RecreateDatabaseSQL string
+	// UniqueISBNSQL turns the books table into the variant where every ISBN is unique.
+	//go:embed unique_isbn.sql
+	UniqueISBNSQL string
+	// UUIDKeySQL turns the books table, along with its price policies and schema variants, into the
+	// variant keyed by UUIDv7.
+	//go:embed uuid_key.sql
+	UUIDKeySQL string
 )
@@ -0,0 +1 @@
+CREATE UNIQUE INDEX IF NOT EXISTS books_isbn_key ON books (isbn);
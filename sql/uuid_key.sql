@@ -0,0 +1,74 @@
+-- uuidv7 returns a UUIDv7: a Unix timestamp in milliseconds followed by random bits. PostgreSQL only
+-- ships it from version 18, whose built-in function in pg_catalog takes precedence over this one.
+CREATE OR REPLACE FUNCTION uuidv7() RETURNS UUID AS $$
+    SELECT encode(
+        set_bit(
+            set_bit(
+                overlay(uuid_send(gen_random_uuid())
+                        PLACING substring(int8send(floor(extract(epoch FROM clock_timestamp()) * 1000)::BIGINT) FROM 3)
+                        FROM 1 FOR 6),
+                52, 1),
+            53, 1),
+        'hex')::UUID;
+$$ LANGUAGE SQL VOLATILE;
+
+DROP TABLE IF EXISTS price_policies;
+DROP TABLE IF EXISTS books;
+DROP TABLE IF EXISTS nullable_books;
+DROP TABLE IF EXISTS json_books;
+DROP TABLE IF EXISTS tagged_books;
+
+CREATE TABLE IF NOT EXISTS books (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS price_policies (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    book_id UUID REFERENCES books(id) ON DELETE CASCADE,
+    price FLOAT NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS nullable_books (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    subtitle VARCHAR(255),
+    description TEXT,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    discontinued_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS json_books (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    metadata JSONB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS tagged_books (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    isbn VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    genre VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    publicized_at TIMESTAMP NOT NULL,
+    tags TEXT[] NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS tagged_books_tags_idx ON tagged_books USING GIN (tags);
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/environment"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/export"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+)
+
+// sweepParameters are the settings a sweep can vary.
+var sweepParameters = []string{
+	utils.BulkInsertNumberKey,
+	utils.BatchSizeKey,
+	utils.PageSizeKey,
+	utils.IDsNumberKey,
+	utils.PoolSizeKey,
+	utils.ParallelismKey,
+}
+
+// parseSweep parses a sweep definition into the parameter and its values. The values are either
+// listed, as in page-size=10,100,1000, or given as a from:to:step range including both ends,
+// where the step adds to the previous value (+10 or 10) or multiplies it (x2).
+func parseSweep(definition string) (string, []int, error) {
+	parameter, spec, ok := strings.Cut(definition, "=")
+	if !ok || spec == "" {
+		return "", nil, errors.New("expected parameter=values")
+	}
+	if !slices.Contains(sweepParameters, parameter) {
+		return "", nil, fmt.Errorf("unknown parameter %q, valid ones are: %s", parameter, strings.Join(sweepParameters, ", "))
+	}
+
+	if !strings.Contains(spec, ":") {
+		var values []int
+		for _, item := range strings.Split(spec, ",") {
+			value, err := strconv.Atoi(strings.TrimSpace(item))
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid value %q", item)
+			}
+			values = append(values, value)
+		}
+		return parameter, values, nil
+	}
+
+	bounds := strings.Split(spec, ":")
+	if len(bounds) != 3 {
+		return "", nil, errors.New("expected a from:to:step range")
+	}
+	from, err := strconv.Atoi(bounds[0])
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid range start %q", bounds[0])
+	}
+	to, err := strconv.Atoi(bounds[1])
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid range end %q", bounds[1])
+	}
+	step, multiply := strings.CutPrefix(bounds[2], "x")
+	increment, err := strconv.Atoi(strings.TrimPrefix(step, "+"))
+	if err != nil || increment < 1 || (multiply && (increment < 2 || from < 1)) {
+		return "", nil, fmt.Errorf("invalid range step %q", bounds[2])
+	}
+
+	var values []int
+	for value := from; value <= to; {
+		values = append(values, value)
+		if multiply {
+			value *= increment
+		} else {
+			value += increment
+		}
+	}
+	if len(values) == 0 {
+		return "", nil, fmt.Errorf("range %s is empty", spec)
+	}
+	return parameter, values, nil
+}
+
+// runSweep executes the operations once per value of the parameter, each value overriding the
+// resolved configuration. Every point is validated before the first one runs, and the adapters
+// are initialized again at every point, so the pool size takes effect too.
+func runSweep(config utils.Config, operations []string, parameter string, values []int) ([]export.SweepPoint, error) {
+	points := make([]export.SweepPoint, 0, len(values))
+	for _, value := range values {
+		point := export.SweepPoint{Value: strconv.Itoa(value), Config: config}
+		if err := point.Config.Set(parameter, point.Value); err != nil {
+			return nil, err
+		}
+		if err := point.Config.Validate(); err != nil {
+			return nil, fmt.Errorf("%s=%d: %w", parameter, value, err)
+		}
+		points = append(points, point)
+	}
+
+	for i := range points {
+		if err := points[i].Config.Apply(); err != nil {
+			return nil, err
+		}
+		points[i].Results = executeBenchmarks(operations, points[i].Config.Count)
+	}
+	return points, nil
+}
+
+// printSweep prints one table per operation, with a row per ORM and a ns/op column per value.
+func printSweep(w io.Writer, config utils.Config, env environment.Environment, parameter string, points []export.SweepPoint) {
+	table := new(tabwriter.Writer)
+	table.Init(w, 0, 8, 2, '\t', tabwriter.AlignRight)
+	settings := slices.DeleteFunc(config.Pairs(), func(pair [2]string) bool {
+		return pair[0] == parameter
+	})
+	_, _ = fmt.Fprintf(table, "Environment: %s\n", formatPairs(env.Pairs()))
+	_, _ = fmt.Fprintf(table, "Config: %s\n", formatPairs(settings))
+
+	operations, orms := export.SweepAxes(points)
+	for _, op := range operations {
+		_, _ = fmt.Fprint(table, "\n")
+		_, _ = fmt.Fprintf(table, "Operation: %s (ns/op by %s)\n", op, parameter)
+		_, _ = fmt.Fprint(table, "\t")
+		for _, p := range points {
+			_, _ = fmt.Fprintf(table, "%s\t", p.Value)
+		}
+		_, _ = fmt.Fprint(table, "\n")
+		for _, orm := range orms {
+			_, _ = fmt.Fprintf(table, "%s:\t", orm)
+			for _, p := range points {
+				_, _ = fmt.Fprintf(table, "%s\t", export.SweepCell(p, orm, op))
+			}
+			_, _ = fmt.Fprint(table, "\n")
+		}
+		_ = table.Flush()
+	}
+
+	for _, p := range points {
+		for _, r := range p.Results {
+			if r.Err != nil {
+				_, _ = fmt.Fprintf(w, "%s=%s: %s failed: %v\n", parameter, p.Value, r.Orm, r.Err)
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"database/sql"
+	"slices"
+	"testing"
+
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark"
+	"github.com/andreiac-silva/golang-orm-benchmarks/benchmark/utils"
+)
+
+func TestParseSweep(t *testing.T) {
+	tests := []struct {
+		definition    string
+		wantParameter string
+		wantValues    []int
+		wantErr       bool
+	}{
+		{definition: "page-size=10,100,1000", wantParameter: "page-size", wantValues: []int{10, 100, 1000}},
+		{definition: "page-size=10, 20", wantParameter: "page-size", wantValues: []int{10, 20}},
+		{definition: "parallelism=1:4:+1", wantParameter: "parallelism", wantValues: []int{1, 2, 3, 4}},
+		{definition: "parallelism=1:10:3", wantParameter: "parallelism", wantValues: []int{1, 4, 7, 10}},
+		{definition: "batch-size=10:45:+10", wantParameter: "batch-size", wantValues: []int{10, 20, 30, 40}},
+		{definition: "bulk-insert-number=10:10000:x10", wantParameter: "bulk-insert-number", wantValues: []int{10, 100, 1000, 10000}},
+		{definition: "pool-size=1:20:x2", wantParameter: "pool-size", wantValues: []int{1, 2, 4, 8, 16}},
+		{definition: "ids-number=5:5:+1", wantParameter: "ids-number", wantValues: []int{5}},
+		{definition: "page-size", wantErr: true},
+		{definition: "page-size=", wantErr: true},
+		{definition: "benchtime=1:2:+1", wantErr: true},
+		{definition: "page-size=10,a", wantErr: true},
+		{definition: "page-size=10:100", wantErr: true},
+		{definition: "page-size=a:100:+1", wantErr: true},
+		{definition: "page-size=10:a:+1", wantErr: true},
+		{definition: "page-size=10:100:+0", wantErr: true},
+		{definition: "page-size=10:100:x1", wantErr: true},
+		{definition: "page-size=0:100:x2", wantErr: true},
+		{definition: "page-size=100:10:+1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.definition, func(t *testing.T) {
+			parameter, values, err := parseSweep(tt.definition)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseSweep(%q) = %s, %v, want an error", tt.definition, parameter, values)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSweep(%q) failed: %v", tt.definition, err)
+			}
+			if parameter != tt.wantParameter || !slices.Equal(values, tt.wantValues) {
+				t.Errorf("parseSweep(%q) = %s, %v, want %s, %v", tt.definition, parameter, values, tt.wantParameter, tt.wantValues)
+			}
+		})
+	}
+}
+
+func TestRunSweepRejectsInvalidPoints(t *testing.T) {
+	tests := []struct {
+		parameter string
+		values    []int
+	}{
+		{parameter: utils.BatchSizeKey, values: []int{10923}},
+		{parameter: utils.PageSizeKey, values: []int{1, 0}},
+		{parameter: utils.ParallelismKey, values: []int{0, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.parameter, func(t *testing.T) {
+			// Validation fails before any point runs, so no database is needed.
+			points, err := runSweep(utils.DefaultConfig(), []string{"insert-bulk"}, tt.parameter, tt.values)
+			if err == nil {
+				t.Fatalf("runSweep(%s=%v) = %d points, want an error", tt.parameter, tt.values, len(points))
+			}
+		})
+	}
+}
+
+func TestRunSweepAcceptsLargeBulkInsertNumbers(t *testing.T) {
+	t.Cleanup(func() {
+		defaults := utils.DefaultConfig()
+		_ = defaults.Apply()
+	})
+
+	// No ORM is loaded, so every point runs without a database.
+	values := []int{10, 100, 1000, 10000, 50000}
+	points, err := runSweep(utils.DefaultConfig(), []string{insertBulkOp}, utils.BulkInsertNumberKey, values)
+	if err != nil {
+		t.Fatalf("runSweep(%s=%v) = %v, want every point to run", utils.BulkInsertNumberKey, values, err)
+	}
+	if len(points) != len(values) {
+		t.Fatalf("runSweep(%s=%v) = %d points, want %d", utils.BulkInsertNumberKey, values, len(points), len(values))
+	}
+	for i, p := range points {
+		if p.Config.BulkInsertNumber != values[i] {
+			t.Errorf("point %d bulk insert number = %d, want %d", i, p.Config.BulkInsertNumber, values[i])
+		}
+	}
+}
+
+// TestRunSweepInsertBulk runs the insert-bulk sweep of GORM against pgx up to 50000 books, past the
+// bind parameters of a single statement, so it needs the database of POSTGRES_DSN.
+func TestRunSweepInsertBulk(t *testing.T) {
+	db, err := sql.Open("pgx", utils.PostgresDSN)
+	if err == nil {
+		err = db.Ping()
+		_ = db.Close()
+	}
+	if utils.PostgresDSN == "" || err != nil {
+		t.Skipf("no database at POSTGRES_DSN: %v", err)
+	}
+	loadBenchmarks([]string{gorm, pgx})
+	t.Cleanup(func() {
+		benchmarksMap = map[string]benchmark.Benchmark{}
+		defaults := utils.DefaultConfig()
+		_ = defaults.Apply()
+	})
+
+	config := utils.DefaultConfig()
+	config.Benchtime = "1x"
+	values := []int{10, 1000, 50000}
+	points, err := runSweep(config, []string{insertBulkOp}, utils.BulkInsertNumberKey, values)
+	if err != nil {
+		t.Fatalf("runSweep(%s=%v) = %v", utils.BulkInsertNumberKey, values, err)
+	}
+	for _, p := range points {
+		for _, r := range p.Results {
+			if r.Err != nil {
+				t.Fatalf("%s at %s: %v", r.Orm, p.Value, r.Err)
+			}
+			if result := r.Benchmarks[insertBulkOp]; result.N == 0 {
+				t.Errorf("%s at %s: insert-bulk failed", r.Orm, p.Value)
+			}
+		}
+	}
+}